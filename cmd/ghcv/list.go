@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/lusingander/ghcv-cli/internal/query"
+)
+
+// parseListArgs parses `[-q QUERY] USER`.
+func parseListArgs(name string, args []string) (string, *query.Query, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	q := fs.String("q", "", "filter `QUERY` (e.g. \"stars:>50 lang:Go -archived\")")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: ghcv %s [-q QUERY] USER\n", name)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return "", nil, err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return "", nil, fmt.Errorf("user is required")
+	}
+	parsed, err := query.Parse(*q)
+	if err != nil {
+		return "", nil, err
+	}
+	return fs.Arg(0), parsed, nil
+}
+
+func runRepos(args []string) error {
+	user, q, err := parseListArgs("repos", args)
+	if err != nil {
+		return err
+	}
+	pred, err := query.Compile(q, query.RepositorySchema)
+	if err != nil {
+		return err
+	}
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+	repos, err := client.QueryUserRepositories(user)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, r := range repos.Repositories {
+		if pred(r) {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", r.Name, r.LangName, r.Stars, r.Url)
+		}
+	}
+	return w.Flush()
+}
+
+func runPullRequests(args []string) error {
+	user, q, err := parseListArgs("prs", args)
+	if err != nil {
+		return err
+	}
+	pred, err := query.Compile(q, query.PullRequestSchema)
+	if err != nil {
+		return err
+	}
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+	prs, err := client.QueryUserPullRequests(user)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, p := range query.PullRequests(prs) {
+		if pred(p) {
+			fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", p.Owner, p.Repository.Name, p.State, p.Title, p.Url)
+		}
+	}
+	return w.Flush()
+}
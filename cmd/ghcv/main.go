@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
@@ -8,18 +9,37 @@ import (
 	"github.com/lusingander/ghcv-cli/internal/ui"
 )
 
-func run(args []string) error {
+func newClient() (*gh.GitHubClient, error) {
 	cfg, err := gh.LoadConfig()
 	if err != nil {
 		cfg, err = gh.Authorize()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := gh.SaveConfig(cfg); err != nil {
-			return err
+			return nil, err
+		}
+	}
+	return gh.NewGitHubClient(cfg), nil
+}
+
+func run(args []string) error {
+	if len(args) > 1 {
+		switch args[1] {
+		case "repos":
+			return runRepos(args[2:])
+		case "prs":
+			return runPullRequests(args[2:])
+		case "report":
+			return runReport(args[2:])
+		default:
+			return fmt.Errorf("unknown command: %s", args[1])
 		}
 	}
-	client := gh.NewGitHubClient(cfg)
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
 	return ui.Start(client)
 }
 
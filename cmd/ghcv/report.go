@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/report"
+)
+
+const (
+	// the points left for other uses of the token
+	reportRateLimitReserve = 100
+)
+
+// rateLimiter makes the workers wait for the reset when the rate limit budget is about to run out.
+// It reads the rate limit from each query, and takes the largest cost so far as the cost of the next query.
+type rateLimiter struct {
+	mu        sync.Mutex
+	known     bool // the rate limit has been read since the start or the last reset
+	remaining int
+	resetAt   time.Time
+	cost      int
+
+	now   func() time.Time
+	sleep func(time.Duration)
+	out   io.Writer
+}
+
+var _ gh.RateLimiter = (*rateLimiter)(nil)
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		cost:  1,
+		now:   time.Now,
+		sleep: time.Sleep,
+		out:   os.Stderr,
+	}
+}
+
+func (l *rateLimiter) Wait() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.known && l.remaining-l.cost < reportRateLimitReserve {
+		// the other workers wait as well, holding the lock
+		fmt.Fprintf(l.out, "rate limit almost exhausted, waiting until %s\n", l.resetAt.Local().Format(time.TimeOnly))
+		l.sleep(l.resetAt.Sub(l.now()) + time.Second)
+		l.known = false
+	}
+	// the queries running at the same time are not yet counted in the rate limit read
+	l.remaining -= l.cost
+	return nil
+}
+
+func (l *rateLimiter) Update(rl *gh.RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.known = true
+	l.remaining = rl.Remaining
+	l.resetAt = rl.ResetAt
+	l.cost = max(l.cost, rl.Cost)
+}
+
+// readLogins reads a login per line, skipping empty lines and lines starting with #.
+func readLogins(r io.Reader) ([]string, error) {
+	logins := make([]string, 0)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		logins = append(logins, line)
+	}
+	return logins, s.Err()
+}
+
+func openLogins(path string) ([]string, error) {
+	if path == "-" {
+		return readLogins(os.Stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readLogins(f)
+}
+
+func runReport(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	users := fs.String("users", "", "`FILE` with a login per line (- for stdin)")
+	format := fs.String("format", "md", "output `FORMAT` ("+strings.Join(report.Formats, ", ")+")")
+	workers := fs.Int("workers", 4, "fetch `N` users concurrently")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: ghcv report --users FILE [--format md|csv|json] [--workers N]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *users == "" || fs.NArg() != 0 {
+		fs.Usage()
+		return fmt.Errorf("users file is required")
+	}
+	// fail before fetching anything
+	if err := report.Write(io.Discard, *format, nil); err != nil {
+		return err
+	}
+	logins, err := openLogins(*users)
+	if err != nil {
+		return err
+	}
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	client.SetRateLimiter(newRateLimiter())
+	now := time.Now()
+	result := report.Collect(logins, *workers, func(login string) (*report.User, error) {
+		u, err := fetchReportUser(client, login, now)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", login, err)
+		}
+		return u, err
+	})
+
+	if err := report.Write(os.Stdout, *format, result); err != nil {
+		return err
+	}
+	if n := report.Failed(result); n > 0 {
+		return fmt.Errorf("failed to fetch %d of %d users", n, len(result))
+	}
+	return nil
+}
+
+func fetchReportUser(client *gh.GitHubClient, login string, now time.Time) (*report.User, error) {
+	profile, err := client.QueryUserProfile(login)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := client.QueryUserRepositories(login)
+	if err != nil {
+		return nil, err
+	}
+	prs, err := client.QueryUserPullRequests(login)
+	if err != nil {
+		return nil, err
+	}
+	return report.Build(profile, repos, prs, now), nil
+}
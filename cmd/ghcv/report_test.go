@@ -0,0 +1,77 @@
+package main
+
+import (
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+// fakeClock is advanced only by sleep.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) sleep(d time.Duration) {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+}
+
+func TestRateLimiter(t *testing.T) {
+	start := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+	resetAt := start.Add(10 * time.Minute)
+	tests := []struct {
+		updates []*gh.RateLimit // the rate limit read after each query, nil if not read
+		want    []time.Duration
+	}{
+		{
+			// unknown before the first query
+			updates: []*gh.RateLimit{nil},
+			want:    nil,
+		},
+		{
+			updates: []*gh.RateLimit{{Cost: 1, Remaining: 200, ResetAt: resetAt}, nil},
+			want:    nil,
+		},
+		{
+			updates: []*gh.RateLimit{{Cost: 1, Remaining: 100, ResetAt: resetAt}, nil},
+			want:    []time.Duration{10*time.Minute + time.Second},
+		},
+		{
+			// the largest cost so far is taken as the next cost
+			updates: []*gh.RateLimit{{Cost: 5, Remaining: 200, ResetAt: resetAt}, {Cost: 1, Remaining: 104, ResetAt: resetAt}, nil},
+			want:    []time.Duration{10*time.Minute + time.Second},
+		},
+		{
+			// the queries running at the same time are counted
+			updates: []*gh.RateLimit{{Cost: 1, Remaining: 102, ResetAt: resetAt}, nil, nil, nil},
+			want:    []time.Duration{10*time.Minute + time.Second},
+		},
+		{
+			// not waiting again until the rate limit is read after the reset
+			updates: []*gh.RateLimit{{Cost: 1, Remaining: 100, ResetAt: resetAt}, nil, nil},
+			want:    []time.Duration{10*time.Minute + time.Second},
+		},
+	}
+	for i, test := range tests {
+		clock := &fakeClock{now: start}
+		l := newRateLimiter()
+		l.now = func() time.Time { return clock.now }
+		l.sleep = clock.sleep
+		l.out = io.Discard
+		for _, rl := range test.updates {
+			if err := l.Wait(); err != nil {
+				t.Fatal(err)
+			}
+			if rl != nil {
+				l.Update(rl)
+			}
+		}
+		if got := clock.sleeps; !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: sleeps got: %v, want: %v", i, got, test.want)
+		}
+	}
+}
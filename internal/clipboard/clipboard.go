@@ -0,0 +1,70 @@
+// Package clipboard copies text to the clipboard of the system,
+// or to the clipboard of the terminal with OSC 52 when the system one is not reachable, such as over SSH.
+package clipboard
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+	"github.com/mattn/go-isatty"
+)
+
+// Method is how the text was copied.
+type Method string
+
+const (
+	System Method = "clipboard"
+	OSC52  Method = "OSC 52"
+)
+
+// ErrNotTerminal is returned when OSC 52 is not sent since nothing would receive it.
+var ErrNotTerminal = errors.New("the output is not a terminal")
+
+// Copy copies the text to the system clipboard, and returns the method used.
+// Over SSH, the system clipboard is of the remote host, so the text is not copied and OSC52 is returned,
+// in which case the caller sends the text to the terminal with WriteOSC52.
+// OSC52 is also returned when the system clipboard is not available.
+func Copy(text string) Method {
+	if !overSSH(os.Getenv) {
+		if err := clipboard.WriteAll(text); err == nil {
+			return System
+		}
+	}
+	return OSC52
+}
+
+// WriteOSC52 sends the text to the clipboard of the terminal.
+// It should be called by the owner of the output, such as the event loop of the application,
+// so that the sequence is not written while the screen is rendered.
+func WriteOSC52(out *os.File, text string) error {
+	if !isatty.IsTerminal(out.Fd()) && !isatty.IsCygwinTerminal(out.Fd()) {
+		return ErrNotTerminal
+	}
+	return writeOSC52(out, text, os.Getenv)
+}
+
+func overSSH(getenv func(string) string) bool {
+	return getenv("SSH_TTY") != "" || getenv("SSH_CONNECTION") != "" || getenv("SSH_CLIENT") != ""
+}
+
+// osc52Sequence returns the sequence to copy the text, wrapped to pass through the terminal multiplexer if any.
+func osc52Sequence(text string, getenv func(string) string) osc52.Sequence {
+	seq := osc52.New(text)
+	switch {
+	case getenv("TMUX") != "":
+		return seq.Tmux()
+	case getenv("STY") != "" || strings.HasPrefix(getenv("TERM"), "screen"):
+		return seq.Screen()
+	}
+	return seq
+}
+
+// writeOSC52 writes the whole sequence at once, so that it is not interleaved with other writes to the terminal.
+func writeOSC52(w io.Writer, text string, getenv func(string) string) error {
+	_, err := osc52Sequence(text, getenv).WriteTo(w)
+	return err
+}
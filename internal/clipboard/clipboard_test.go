@@ -0,0 +1,73 @@
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func TestWriteOSC52(t *testing.T) {
+	tests := []struct {
+		env     map[string]string
+		want    string
+		overSSH bool
+	}{
+		{
+			env:     map[string]string{},
+			want:    "\x1b]52;c;Zm9v\x07",
+			overSSH: false,
+		},
+		{
+			env:     map[string]string{"SSH_TTY": "/dev/pts/0"},
+			want:    "\x1b]52;c;Zm9v\x07",
+			overSSH: true,
+		},
+		{
+			env:     map[string]string{"SSH_CONNECTION": "10.0.0.1 22 10.0.0.2 22", "TMUX": "/tmp/tmux-1000/default,1,0"},
+			want:    "\x1bPtmux;\x1b\x1b]52;c;Zm9v\x07\x1b\\",
+			overSSH: true,
+		},
+		{
+			env:     map[string]string{"TERM": "screen-256color"},
+			want:    "\x1bP\x1b]52;c;Zm9v\x07\x1b\\",
+			overSSH: false,
+		},
+	}
+	for _, test := range tests {
+		getenv := func(k string) string { return test.env[k] }
+		var buf bytes.Buffer
+		if err := writeOSC52(&buf, "foo", getenv); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); notEqual(got, test.want) {
+			t.Errorf("env: %v, got: %q, want: %q", test.env, got, test.want)
+		}
+		if got := overSSH(getenv); notEqual(got, test.overSSH) {
+			t.Errorf("env: %v, overSSH got: %v, want: %v", test.env, got, test.overSSH)
+		}
+	}
+}
+
+func TestWriteOSC52NotTerminal(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := WriteOSC52(f, "foo"); !errors.Is(err, ErrNotTerminal) {
+		t.Errorf("got: %v, want: %v", err, ErrNotTerminal)
+	}
+	if b, _ := os.ReadFile(f.Name()); len(b) != 0 {
+		t.Errorf("written: %q", b)
+	}
+}
@@ -12,7 +12,8 @@ import (
 )
 
 type GitHubClient struct {
-	client *githubv4.Client
+	client  *githubv4.Client
+	limiter RateLimiter
 }
 
 func NewGitHubClient(cfg *GithubConfig) *GitHubClient {
@@ -39,6 +40,115 @@ func (c *GitHubClient) ExistUser(id string) bool {
 	return err == nil
 }
 
+type UserSuggestion struct {
+	Login     string
+	Name      string
+	Followers int
+}
+
+type searchUsersQuery struct {
+	Search struct {
+		Nodes []searchUsersQueryNode
+	} `graphql:"search(query: $query, type: USER, first: $first)"`
+}
+
+type searchUsersQueryNode struct {
+	User searchUsersQueryUser `graphql:"... on User"`
+}
+
+type searchUsersQueryUser struct {
+	Login     githubv4.String
+	Name      githubv4.String
+	Followers struct {
+		TotalCount githubv4.Int
+	}
+}
+
+func (q *searchUsersQuery) toUserSuggestions() []*UserSuggestion {
+	ret := make([]*UserSuggestion, 0, len(q.Search.Nodes))
+	for _, node := range q.Search.Nodes {
+		// organizations are also returned, but they are not users
+		if node.User.Login == "" {
+			continue
+		}
+		ret = append(ret, &UserSuggestion{
+			Login:     string(node.User.Login),
+			Name:      string(node.User.Name),
+			Followers: int(node.User.Followers.TotalCount),
+		})
+	}
+	return ret
+}
+
+// SearchUsers returns at most n users matching the query, in the order of GitHub's relevance.
+func (c *GitHubClient) SearchUsers(q string, n int) ([]*UserSuggestion, error) {
+	var query searchUsersQuery
+	variables := map[string]interface{}{
+		"query": githubv4.String(q),
+		"first": githubv4.Int(n),
+	}
+	if err := c.client.Query(context.Background(), &query, variables); err != nil {
+		return nil, err
+	}
+	return query.toUserSuggestions(), nil
+}
+
+type RateLimit struct {
+	Cost      int // the cost of the query which read the rate limit
+	Remaining int
+	ResetAt   time.Time
+}
+
+// RateLimiter makes the queries of the client wait when the rate limit is about to run out.
+type RateLimiter interface {
+	// Wait is called before a query.
+	Wait() error
+	// Update is called with the rate limit read with a query.
+	Update(rl *RateLimit)
+}
+
+// SetRateLimiter sets the rate limiter of the queries of the users, such as QueryUserProfile.
+func (c *GitHubClient) SetRateLimiter(l RateLimiter) {
+	c.limiter = l
+}
+
+// rateLimitQuery is embedded in the queries to read the rate limit with them.
+type rateLimitQuery struct {
+	RateLimit struct {
+		Cost      githubv4.Int
+		Remaining githubv4.Int
+		ResetAt   githubv4.DateTime
+	}
+}
+
+func (q *rateLimitQuery) toRateLimit() *RateLimit {
+	return &RateLimit{
+		Cost:      int(q.RateLimit.Cost),
+		Remaining: int(q.RateLimit.Remaining),
+		ResetAt:   q.RateLimit.ResetAt.Time,
+	}
+}
+
+type rateLimitedQuery interface {
+	toRateLimit() *RateLimit
+}
+
+// queryRateLimited runs the query, which embeds rateLimitQuery, with the rate limiter.
+func (c *GitHubClient) queryRateLimited(query rateLimitedQuery, variables map[string]interface{}) error {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(); err != nil {
+			return err
+		}
+	}
+	if err := c.client.Query(context.Background(), query, variables); err != nil {
+		return err
+	}
+	if c.limiter != nil {
+		c.limiter.Update(query.toRateLimit())
+	}
+	return nil
+}
+
 type UserProfile struct {
 	Login      string
 	Name       string
@@ -50,9 +160,14 @@ type UserProfile struct {
 	WebsiteUrl string
 	AvatarUrl  string
 	Url        string
+
+	// organizations have members instead of followers
+	IsOrganization bool
+	Members        int
 }
 
 type userProfileQuery struct {
+	rateLimitQuery
 	User struct {
 		Login     githubv4.String
 		Name      githubv4.String
@@ -86,15 +201,52 @@ func (q *userProfileQuery) toUserProfile() *UserProfile {
 	}
 }
 
+type organizationProfileQuery struct {
+	rateLimitQuery
+	Organization struct {
+		Login           githubv4.String
+		Name            githubv4.String
+		Description     githubv4.String
+		Location        githubv4.String
+		WebsiteUrl      githubv4.String
+		AvatarUrl       githubv4.String
+		Url             githubv4.String
+		MembersWithRole struct {
+			TotalCount githubv4.Int
+		}
+	} `graphql:"organization(login: $login)"`
+}
+
+func (q *organizationProfileQuery) toUserProfile() *UserProfile {
+	return &UserProfile{
+		Login:          string(q.Organization.Login),
+		Name:           string(q.Organization.Name),
+		Bio:            string(q.Organization.Description),
+		Location:       string(q.Organization.Location),
+		WebsiteUrl:     string(q.Organization.WebsiteUrl),
+		AvatarUrl:      string(q.Organization.AvatarUrl),
+		Url:            string(q.Organization.Url),
+		IsOrganization: true,
+		Members:        int(q.Organization.MembersWithRole.TotalCount),
+	}
+}
+
+// QueryUserProfile returns the profile of the user, or the organization if id is not a user.
 func (c *GitHubClient) QueryUserProfile(id string) (*UserProfile, error) {
 	var query userProfileQuery
 	variables := map[string]interface{}{
 		"login": githubv4.String(id),
 	}
-	if err := c.client.Query(context.Background(), &query, variables); err != nil {
+	err := c.queryRateLimited(&query, variables)
+	if err == nil {
+		return query.toUserProfile(), nil
+	}
+	var orgQuery organizationProfileQuery
+	if orgErr := c.queryRateLimited(&orgQuery, variables); orgErr != nil {
+		// the error of the user is more relevant in most cases
 		return nil, err
 	}
-	return query.toUserProfile(), nil
+	return orgQuery.toUserProfile(), nil
 }
 
 type UserPullRequests struct {
@@ -113,6 +265,7 @@ func (p *UserPullRequests) Owner(owner string) *UserPullRequestsOwner {
 
 type UserPullRequestsOwner struct {
 	Name         string
+	Organization bool // whether the owner is an organization, not a user
 	Repositories []*UserPullRequestsRepository
 }
 
@@ -141,6 +294,7 @@ type UserPullRequestsPullRequest struct {
 }
 
 type userPullRequestsQuery struct {
+	rateLimitQuery
 	Search struct {
 		IssueCount githubv4.Int
 		Edges      []userPullRequestsQueryEdge
@@ -169,7 +323,8 @@ type userPullRequestsQueryRepository struct {
 	Description githubv4.String
 	Url         githubv4.String
 	Owner       struct {
-		Login githubv4.String
+		Typename githubv4.String `graphql:"__typename"`
+		Login    githubv4.String
 	}
 	PrimaryLanguage struct {
 		Name  githubv4.String
@@ -287,9 +442,11 @@ func (m *repoMap) Keys() []string {
 
 func (q *userPullRequestsQuery) toUserPullRequests() *UserPullRequests {
 	rnMap := newRepoNodesMap()
+	organizations := make(map[string]bool)
 	for _, edge := range q.Search.Edges {
 		repo := edge.Node.PullRequest.Repository
 		ownerName := string(repo.Owner.Login)
+		organizations[ownerName] = repo.Owner.Typename == "Organization"
 		repoName := string(repo.Name)
 		key := fmt.Sprintf("%s/%s", ownerName, repoName)
 		if !rnMap.Exist(key) {
@@ -347,6 +504,7 @@ func (q *userPullRequestsQuery) toUserPullRequests() *UserPullRequests {
 		}
 		owner := &UserPullRequestsOwner{
 			Name:         ownerName,
+			Organization: organizations[ownerName],
 			Repositories: repositories,
 		}
 		owners = append(owners, owner)
@@ -392,7 +550,7 @@ func (c *GitHubClient) queryUserPullRequests(id, cursorAfter string) (*userPullR
 	} else {
 		variables["after"] = githubv4.String(cursorAfter)
 	}
-	if err := c.client.Query(context.Background(), &query, variables); err != nil {
+	if err := c.queryRateLimited(&query, variables); err != nil {
 		return nil, err
 	}
 	return &query, nil
@@ -403,15 +561,15 @@ func (c *GitHubClient) QueryUserRepositories(id string) (*UserRepositories, erro
 	if err != nil {
 		return nil, err
 	}
-	hasNext := bool(q.User.Repositories.PageInfo.HasNextPage)
-	cursor := string(q.User.Repositories.PageInfo.EndCursor)
+	hasNext := bool(q.Owner.Repositories.PageInfo.HasNextPage)
+	cursor := string(q.Owner.Repositories.PageInfo.EndCursor)
 	for hasNext {
 		qq, err := c.queryUserRepositories(id, cursor)
 		if err != nil {
 			return nil, err
 		}
-		hasNext = bool(qq.User.Repositories.PageInfo.HasNextPage)
-		cursor = string(qq.User.Repositories.PageInfo.EndCursor)
+		hasNext = bool(qq.Owner.Repositories.PageInfo.HasNextPage)
+		cursor = string(qq.Owner.Repositories.PageInfo.EndCursor)
 		q.merge(qq)
 	}
 	return q.toUserRepositories(), nil
@@ -428,7 +586,7 @@ func (c *GitHubClient) queryUserRepositories(id, cursorAfter string) (*userRepos
 	} else {
 		variables["after"] = githubv4.String(cursorAfter)
 	}
-	if err := c.client.Query(context.Background(), &query, variables); err != nil {
+	if err := c.queryRateLimited(&query, variables); err != nil {
 		return nil, err
 	}
 	return &query, nil
@@ -451,24 +609,27 @@ type UserRepository struct {
 	OpenedIssues       int
 	OpenedPullRequests int
 	License            string
+	Archived           bool
 	CreatedAt          time.Time
 	PushedAt           time.Time
 }
 
+// the repositories are queried through repositoryOwner, so that the repositories of organizations can also be fetched
 type userRepositoriesQuery struct {
-	User struct {
+	rateLimitQuery
+	Owner struct {
 		Repositories struct {
 			TotalCount githubv4.Int
 			PageInfo   pageInfo
 			Edges      []userRepositoriesQueryEdge
 		} `graphql:"repositories(orderBy:{direction:DESC,field:STARGAZERS},privacy:PUBLIC,isFork:false,first:$first,after:$after)"`
-	} `graphql:"user(login:$login)"`
+	} `graphql:"repositoryOwner(login:$login)"`
 }
 
 func (q *userRepositoriesQuery) merge(qq *userRepositoriesQuery) {
-	q.User.Repositories.TotalCount = qq.User.Repositories.TotalCount
-	q.User.Repositories.PageInfo = qq.User.Repositories.PageInfo
-	q.User.Repositories.Edges = append(q.User.Repositories.Edges, qq.User.Repositories.Edges...)
+	q.Owner.Repositories.TotalCount = qq.Owner.Repositories.TotalCount
+	q.Owner.Repositories.PageInfo = qq.Owner.Repositories.PageInfo
+	q.Owner.Repositories.Edges = append(q.Owner.Repositories.Edges, qq.Owner.Repositories.Edges...)
 }
 
 type pageInfo struct {
@@ -516,7 +677,7 @@ type userRepositoriesQueryEdge struct {
 
 func (q *userRepositoriesQuery) toUserRepositories() *UserRepositories {
 	repositories := make([]*UserRepository, 0)
-	for _, edge := range q.User.Repositories.Edges {
+	for _, edge := range q.Owner.Repositories.Edges {
 		r := edge.Node
 		repository := &UserRepository{
 			Name:               string(r.Name),
@@ -530,13 +691,14 @@ func (q *userRepositoriesQuery) toUserRepositories() *UserRepositories {
 			OpenedIssues:       int(r.Issues.TotalCount),
 			OpenedPullRequests: int(r.PullRequests.TotalCount),
 			License:            string(r.LicenseInfo.SpdxId),
+			Archived:           bool(r.IsArchived),
 			CreatedAt:          r.CreatedAt.Time,
 			PushedAt:           r.PushedAt.Time,
 		}
 		repositories = append(repositories, repository)
 	}
 	return &UserRepositories{
-		TotalCount:   int(q.User.Repositories.TotalCount),
+		TotalCount:   int(q.Owner.Repositories.TotalCount),
 		Repositories: repositories,
 	}
 }
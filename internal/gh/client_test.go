@@ -1,8 +1,12 @@
 package gh
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/shurcooL/githubv4"
 )
@@ -70,3 +74,232 @@ func Test_userProfileQuery_toUserProfile(t *testing.T) {
 		t.Errorf("got: %v, want: %v", got, want)
 	}
 }
+
+func Test_organizationProfileQuery_toUserProfile(t *testing.T) {
+	var q organizationProfileQuery
+	err := json.Unmarshal([]byte(`{
+		"organization": {
+			"login": "foo",
+			"name": "Foo Inc.",
+			"description": "bar",
+			"location": "japan",
+			"websiteUrl": "http://example.com/qux",
+			"avatarUrl": "http://example.com/foo.png",
+			"url": "http://example.com/foo",
+			"membersWithRole": {"totalCount": 42}
+		}
+	}`), &q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &UserProfile{
+		Login:          "foo",
+		Name:           "Foo Inc.",
+		Bio:            "bar",
+		Location:       "japan",
+		WebsiteUrl:     "http://example.com/qux",
+		AvatarUrl:      "http://example.com/foo.png",
+		Url:            "http://example.com/foo",
+		IsOrganization: true,
+		Members:        42,
+	}
+	got := q.toUserProfile()
+	if notEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func Test_searchUsersQuery_toUserSuggestions(t *testing.T) {
+	user := func(login, name string, followers int) searchUsersQueryNode {
+		var n searchUsersQueryNode
+		n.User.Login = githubv4.String(login)
+		n.User.Name = githubv4.String(name)
+		n.User.Followers.TotalCount = githubv4.Int(followers)
+		return n
+	}
+	var q searchUsersQuery
+	q.Search.Nodes = []searchUsersQueryNode{
+		user("foo", "foo bar", 12),
+		{}, // organization
+		user("baz", "", 0),
+	}
+	want := []*UserSuggestion{
+		{Login: "foo", Name: "foo bar", Followers: 12},
+		{Login: "baz", Name: "", Followers: 0},
+	}
+	got := q.toUserSuggestions()
+	if notEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func Test_teamMemberQueryUser_toTeamMember(t *testing.T) {
+	var u teamMemberQueryUser
+	err := json.Unmarshal([]byte(`{
+		"login": "foo",
+		"name": "foo bar",
+		"repositories": {
+			"nodes": [
+				{"pushedAt": "2024-03-10T00:00:00Z"},
+				{"pushedAt": "2024-03-01T00:00:00Z"},
+				{"pushedAt": "2024-02-29T00:00:00Z"}
+			]
+		},
+		"contributionsCollection": {
+			"contributionCalendar": {
+				"weeks": [
+					{"contributionDays": [{"contributionCount": 1}, {"contributionCount": 2}]},
+					{"contributionDays": [{"contributionCount": 0}, {"contributionCount": 5}]}
+				]
+			}
+		}
+	}`), &u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged := &teamMemberQuerySearch{IssueCount: 3}
+	open := &teamMemberQuerySearch{IssueCount: 1}
+	now := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	want := &TeamMember{
+		Login:              "foo",
+		Name:               "foo bar",
+		MergedPullRequests: 3,
+		OpenPullRequests:   1,
+		PushedRepositories: 2,
+		Contributions:      []int{3, 5},
+	}
+	got := u.toTeamMember(merged, open, now)
+	if notEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func Test_teamMembersFromQuery(t *testing.T) {
+	now := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		logins   []string
+		resolved []string // the logins returned by the query
+		err      error    // the error of the query
+		want     []string // the errors of the members
+	}{
+		{
+			logins:   []string{"foo", "bar"},
+			resolved: []string{"foo", "bar"},
+			err:      nil,
+			want:     []string{"", ""},
+		},
+		{
+			logins:   []string{"foo", "typo", "bar"},
+			resolved: []string{"foo", "bar"},
+			err:      nil,
+			want:     []string{"", "could not resolve user typo", ""},
+		},
+		{
+			logins:   []string{"typo", "typo2"},
+			resolved: []string{},
+			err:      nil,
+			want:     []string{"could not resolve user typo", "could not resolve user typo2"},
+		},
+		{
+			logins:   []string{"foo", "bar"},
+			resolved: []string{},
+			err:      errors.New("timeout"),
+			want:     []string{"timeout", "timeout"},
+		},
+		{
+			logins:   []string{"foo", "typo"},
+			resolved: []string{"foo"},
+			err:      errors.New("Could not resolve to a User with the login of 'typo'."),
+			want:     []string{"", "Could not resolve to a User with the login of 'typo'."},
+		},
+	}
+	for _, test := range tests {
+		query := newTeamMembersQuery(len(test.logins))
+		for i, login := range test.logins {
+			for _, r := range test.resolved {
+				if r == login {
+					query.Elem().FieldByName(fmt.Sprintf("U%d", i)).Set(reflect.ValueOf(teamMemberQueryUser{Login: githubv4.String(login)}))
+				}
+			}
+		}
+		members := teamMembersFromQuery(query.Elem(), test.logins, now, test.err)
+		got := make([]string, 0)
+		for i, member := range members {
+			if notEqual(member.Login, test.logins[i]) {
+				t.Errorf("logins: %v, login got: %s, want: %s", test.logins, member.Login, test.logins[i])
+			}
+			if member.Err != nil {
+				got = append(got, member.Err.Error())
+			} else {
+				got = append(got, "")
+			}
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("logins: %v, err: %v, errors got: %q, want: %q", test.logins, test.err, got, test.want)
+		}
+	}
+}
+
+func Test_followsQueryConnection_toFollows(t *testing.T) {
+	var q followsQueryConnection
+	err := json.Unmarshal([]byte(`{
+		"totalCount": 120,
+		"nodes": [
+			{"login": "foo", "name": "foo bar", "bio": "baz", "followers": {"totalCount": 3}, "url": "http://example.com/foo"},
+			{"login": "qux", "name": "", "bio": "", "followers": {"totalCount": 0}, "url": "http://example.com/qux"}
+		],
+		"pageInfo": {"endCursor": "abc", "hasNextPage": true}
+	}`), &q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Follows{
+		TotalCount: 120,
+		Users: []*FollowUser{
+			{Login: "foo", Name: "foo bar", Bio: "baz", Followers: 3, Url: "http://example.com/foo"},
+			{Login: "qux", Name: "", Bio: "", Followers: 0, Url: "http://example.com/qux"},
+		},
+		EndCursor:   "abc",
+		HasNextPage: true,
+	}
+	got := q.toFollows()
+	if notEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func Test_repositoryReadmeQuery_toReadme(t *testing.T) {
+	blob := func(text string) *readmeQueryBlob {
+		b := &readmeQueryBlob{}
+		b.Blob.Text = githubv4.String(text)
+		return b
+	}
+	tests := []struct {
+		setup func(q *repositoryReadmeQuery)
+		want  string
+	}{
+		{
+			setup: func(q *repositoryReadmeQuery) {},
+			want:  "",
+		},
+		{
+			setup: func(q *repositoryReadmeQuery) { q.Repository.ReadmeRst = blob("rst") },
+			want:  "rst",
+		},
+		{
+			setup: func(q *repositoryReadmeQuery) {
+				q.Repository.Readme = blob("plain")
+				q.Repository.ReadmeMd = blob("md")
+			},
+			want: "md",
+		},
+	}
+	for _, tt := range tests {
+		var q repositoryReadmeQuery
+		tt.setup(&q)
+		got := q.toReadme()
+		if notEqual(got, tt.want) {
+			t.Errorf("got: %v, want: %v", got, tt.want)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
 )
 
 const (
@@ -15,11 +17,11 @@ type GithubConfig struct {
 }
 
 func configFilePath() (string, error) {
-	home, err := os.UserHomeDir()
+	dir, err := ghcv.ConfigDir()
 	if err != nil {
 		return "", err
 	}
-	path := filepath.Join(home, ".config", "ghcv-cli", "config.json")
+	path := filepath.Join(dir, "config.json")
 	return path, nil
 }
 
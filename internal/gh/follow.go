@@ -0,0 +1,109 @@
+package gh
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// the number of users fetched in a single query
+	followsPageSize = 100
+)
+
+type FollowUser struct {
+	Login     string
+	Name      string
+	Bio       string
+	Followers int
+	Url       string
+}
+
+// Follows is a page of the followers or the following of a user.
+type Follows struct {
+	TotalCount  int
+	Users       []*FollowUser
+	EndCursor   string
+	HasNextPage bool
+}
+
+type followsQueryConnection struct {
+	TotalCount githubv4.Int
+	Nodes      []struct {
+		Login     githubv4.String
+		Name      githubv4.String
+		Bio       githubv4.String
+		Followers struct {
+			TotalCount githubv4.Int
+		}
+		Url githubv4.String
+	}
+	PageInfo struct {
+		EndCursor   githubv4.String
+		HasNextPage githubv4.Boolean
+	}
+}
+
+type followersQuery struct {
+	User struct {
+		Followers followsQueryConnection `graphql:"followers(first: $first, after: $after)"`
+	} `graphql:"user(login: $login)"`
+}
+
+type followingQuery struct {
+	User struct {
+		Following followsQueryConnection `graphql:"following(first: $first, after: $after)"`
+	} `graphql:"user(login: $login)"`
+}
+
+func (q *followsQueryConnection) toFollows() *Follows {
+	users := make([]*FollowUser, len(q.Nodes))
+	for i, node := range q.Nodes {
+		users[i] = &FollowUser{
+			Login:     string(node.Login),
+			Name:      string(node.Name),
+			Bio:       string(node.Bio),
+			Followers: int(node.Followers.TotalCount),
+			Url:       string(node.Url),
+		}
+	}
+	return &Follows{
+		TotalCount:  int(q.TotalCount),
+		Users:       users,
+		EndCursor:   string(q.PageInfo.EndCursor),
+		HasNextPage: bool(q.PageInfo.HasNextPage),
+	}
+}
+
+func followsQueryVariables(id, cursorAfter string) map[string]interface{} {
+	variables := map[string]interface{}{
+		"login": githubv4.String(id),
+		"first": githubv4.Int(followsPageSize),
+	}
+	if cursorAfter == "" {
+		variables["after"] = (*githubv4.String)(nil)
+	} else {
+		variables["after"] = githubv4.String(cursorAfter)
+	}
+	return variables
+}
+
+// QueryFollowers returns the page of the followers of the user after the cursor.
+// The first page is returned if cursorAfter is empty.
+func (c *GitHubClient) QueryFollowers(id, cursorAfter string) (*Follows, error) {
+	var query followersQuery
+	if err := c.client.Query(context.Background(), &query, followsQueryVariables(id, cursorAfter)); err != nil {
+		return nil, err
+	}
+	return query.User.Followers.toFollows(), nil
+}
+
+// QueryFollowing returns the page of the users followed by the user after the cursor.
+// The first page is returned if cursorAfter is empty.
+func (c *GitHubClient) QueryFollowing(id, cursorAfter string) (*Follows, error) {
+	var query followingQuery
+	if err := c.client.Query(context.Background(), &query, followsQueryVariables(id, cursorAfter)); err != nil {
+		return nil, err
+	}
+	return query.User.Following.toFollows(), nil
+}
@@ -0,0 +1,69 @@
+package gh
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+)
+
+type readmeQueryBlob struct {
+	Blob struct {
+		Text githubv4.String
+	} `graphql:"... on Blob"`
+}
+
+// the README is looked up by the common file names, since the GraphQL API does not resolve it
+type repositoryReadmeQuery struct {
+	Repository struct {
+		ReadmeMd    *readmeQueryBlob `graphql:"readmeMd: object(expression: \"HEAD:README.md\")"`
+		ReadmeLower *readmeQueryBlob `graphql:"readmeLower: object(expression: \"HEAD:readme.md\")"`
+		ReadmeRst   *readmeQueryBlob `graphql:"readmeRst: object(expression: \"HEAD:README.rst\")"`
+		ReadmeTxt   *readmeQueryBlob `graphql:"readmeTxt: object(expression: \"HEAD:README.txt\")"`
+		Readme      *readmeQueryBlob `graphql:"readme: object(expression: \"HEAD:README\")"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+func (q *repositoryReadmeQuery) toReadme() string {
+	r := q.Repository
+	for _, blob := range []*readmeQueryBlob{r.ReadmeMd, r.ReadmeLower, r.ReadmeRst, r.ReadmeTxt, r.Readme} {
+		if blob != nil {
+			return string(blob.Blob.Text)
+		}
+	}
+	return ""
+}
+
+// QueryRepositoryReadme returns the README of the default branch of the repository, or empty if there is none.
+func (c *GitHubClient) QueryRepositoryReadme(owner, name string) (string, error) {
+	var query repositoryReadmeQuery
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(name),
+	}
+	if err := c.client.Query(context.Background(), &query, variables); err != nil {
+		return "", err
+	}
+	return query.toReadme(), nil
+}
+
+type pullRequestBodyQuery struct {
+	Repository struct {
+		PullRequest struct {
+			Body githubv4.String
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// QueryPullRequestBody returns the description of the pull request.
+func (c *GitHubClient) QueryPullRequestBody(owner, name string, number int) (string, error) {
+	var query pullRequestBodyQuery
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"name":   githubv4.String(name),
+		"number": githubv4.Int(number),
+	}
+	if err := c.client.Query(context.Background(), &query, variables); err != nil {
+		return "", err
+	}
+	return string(query.Repository.PullRequest.Body), nil
+}
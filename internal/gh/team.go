@@ -0,0 +1,222 @@
+package gh
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// the number of members fetched in a single query
+	teamMembersBatchSize = 10
+	// the number of weeks of TeamMember.Contributions
+	teamContributionWeeks = 26
+	// the period of TeamMember.MergedPullRequests
+	teamRecentDays = 30
+)
+
+type TeamMember struct {
+	Login string
+	Name  string
+
+	MergedPullRequests int // merged in the last 30 days
+	OpenPullRequests   int
+	PushedRepositories int // pushed in the current month
+
+	Contributions []int // weekly contributions of the last 26 weeks, the oldest first
+
+	// set if the member could not be fetched, such as a login which does not exist, then only Login is set
+	Err error
+}
+
+type teamMemberQueryUser struct {
+	Login        githubv4.String
+	Name         githubv4.String
+	Repositories struct {
+		Nodes []struct {
+			PushedAt githubv4.DateTime
+		}
+	} `graphql:"repositories(first: 100, ownerAffiliations: OWNER, isFork: false, orderBy: {field: PUSHED_AT, direction: DESC})"`
+	ContributionsCollection struct {
+		ContributionCalendar struct {
+			Weeks []struct {
+				ContributionDays []struct {
+					ContributionCount githubv4.Int
+				}
+			}
+		}
+	}
+}
+
+type teamMemberQuerySearch struct {
+	IssueCount githubv4.Int
+}
+
+// newTeamMembersQuery builds a query struct that fetches n members at once.
+// The fields of the i-th member are aliased as ui, mi and oi,
+// since a struct type cannot have a variable number of fields.
+func newTeamMembersQuery(n int) reflect.Value {
+	fields := make([]reflect.StructField, 0, n*3)
+	for i := 0; i < n; i++ {
+		fields = append(fields,
+			reflect.StructField{
+				Name: fmt.Sprintf("U%d", i),
+				Type: reflect.TypeOf(teamMemberQueryUser{}),
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"u%d: user(login: $login%d)"`, i, i)),
+			},
+			reflect.StructField{
+				Name: fmt.Sprintf("M%d", i),
+				Type: reflect.TypeOf(teamMemberQuerySearch{}),
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"m%d: search(query: $merged%d, type: ISSUE, first: 1)"`, i, i)),
+			},
+			reflect.StructField{
+				Name: fmt.Sprintf("O%d", i),
+				Type: reflect.TypeOf(teamMemberQuerySearch{}),
+				Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"o%d: search(query: $open%d, type: ISSUE, first: 1)"`, i, i)),
+			},
+		)
+	}
+	return reflect.New(reflect.StructOf(fields))
+}
+
+func teamMembersQueryVariables(logins []string, now time.Time) map[string]interface{} {
+	since := now.AddDate(0, 0, -teamRecentDays).Format("2006-01-02")
+	variables := make(map[string]interface{})
+	for i, login := range logins {
+		variables[fmt.Sprintf("login%d", i)] = githubv4.String(login)
+		// same conditions as QueryUserPullRequests
+		variables[fmt.Sprintf("merged%d", i)] = githubv4.String(fmt.Sprintf("author:%s -user:%s is:pr is:merged merged:>=%s", login, login, since))
+		variables[fmt.Sprintf("open%d", i)] = githubv4.String(fmt.Sprintf("author:%s -user:%s is:pr is:open", login, login))
+	}
+	return variables
+}
+
+func (u *teamMemberQueryUser) toTeamMember(merged, open *teamMemberQuerySearch, now time.Time) *TeamMember {
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	pushed := 0
+	for _, node := range u.Repositories.Nodes {
+		if !node.PushedAt.Time.Before(month) {
+			pushed += 1
+		}
+	}
+	weeks := u.ContributionsCollection.ContributionCalendar.Weeks
+	weeks = weeks[max(len(weeks)-teamContributionWeeks, 0):]
+	contributions := make([]int, len(weeks))
+	for i, week := range weeks {
+		for _, day := range week.ContributionDays {
+			contributions[i] += int(day.ContributionCount)
+		}
+	}
+	return &TeamMember{
+		Login:              string(u.Login),
+		Name:               string(u.Name),
+		MergedPullRequests: int(merged.IssueCount),
+		OpenPullRequests:   int(open.IssueCount),
+		PushedRepositories: pushed,
+		Contributions:      contributions,
+	}
+}
+
+// QueryTeamMembers fetches the activities of the users,
+// batching several users into each query to reduce the round trips.
+// The members which cannot be fetched are returned with Err,
+// and an error is returned only if none of the members can be fetched.
+func (c *GitHubClient) QueryTeamMembers(logins []string) ([]*TeamMember, error) {
+	now := time.Now()
+	ret := make([]*TeamMember, 0, len(logins))
+	var firstErr error
+	for start := 0; start < len(logins); start += teamMembersBatchSize {
+		batch := logins[start:min(start+teamMembersBatchSize, len(logins))]
+		members, err := c.queryTeamMembers(batch, now)
+		if firstErr == nil {
+			firstErr = err
+		}
+		ret = append(ret, members...)
+	}
+	for _, member := range ret {
+		if member.Err == nil {
+			return ret, nil
+		}
+	}
+	return nil, firstErr
+}
+
+// queryTeamMembers returns all the members, with the error of the query if any.
+func (c *GitHubClient) queryTeamMembers(logins []string, now time.Time) ([]*TeamMember, error) {
+	query := newTeamMembersQuery(len(logins))
+	variables := teamMembersQueryVariables(logins, now)
+	// the data of the other users is still returned if some logins do not resolve
+	err := c.client.Query(context.Background(), query.Interface(), variables)
+	return teamMembersFromQuery(query.Elem(), logins, now, err), err
+}
+
+// teamMembersFromQuery converts the result of the query, with the error of the query set to the members not returned.
+func teamMembersFromQuery(q reflect.Value, logins []string, now time.Time, err error) []*TeamMember {
+	members := make([]*TeamMember, len(logins))
+	for i, login := range logins {
+		u := q.FieldByName(fmt.Sprintf("U%d", i)).Interface().(teamMemberQueryUser)
+		if u.Login == "" {
+			memberErr := err
+			if memberErr == nil {
+				// the user is null in the successful response
+				memberErr = fmt.Errorf("could not resolve user %s", login)
+			}
+			members[i] = &TeamMember{Login: login, Err: memberErr}
+			continue
+		}
+		merged := q.FieldByName(fmt.Sprintf("M%d", i)).Interface().(teamMemberQuerySearch)
+		open := q.FieldByName(fmt.Sprintf("O%d", i)).Interface().(teamMemberQuerySearch)
+		members[i] = u.toTeamMember(&merged, &open, now)
+	}
+	return members
+}
+
+type organizationTeamMembersQuery struct {
+	Organization struct {
+		Team struct {
+			// empty if the team is not found
+			Slug    githubv4.String
+			Members struct {
+				Nodes []struct {
+					Login githubv4.String
+				}
+				PageInfo struct {
+					EndCursor   githubv4.String
+					HasNextPage githubv4.Boolean
+				}
+			} `graphql:"members(first: 100, after: $after)"`
+		} `graphql:"team(slug: $slug)"`
+	} `graphql:"organization(login: $org)"`
+}
+
+// QueryOrganizationTeamMembers returns the logins of the members of the team, or an error if the organization or the team is not found.
+// The access token must be able to read the organization's teams.
+func (c *GitHubClient) QueryOrganizationTeamMembers(org, slug string) ([]string, error) {
+	logins := make([]string, 0)
+	variables := map[string]interface{}{
+		"org":   githubv4.String(org),
+		"slug":  githubv4.String(slug),
+		"after": (*githubv4.String)(nil),
+	}
+	for {
+		var query organizationTeamMembersQuery
+		if err := c.client.Query(context.Background(), &query, variables); err != nil {
+			return nil, err
+		}
+		if query.Organization.Team.Slug == "" {
+			return nil, fmt.Errorf("team %s not found in %s", slug, org)
+		}
+		members := query.Organization.Team.Members
+		for _, node := range members.Nodes {
+			logins = append(logins, string(node.Login))
+		}
+		if !members.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = githubv4.NewString(members.PageInfo.EndCursor)
+	}
+	return logins, nil
+}
@@ -0,0 +1,59 @@
+package ghcv
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ConfigDir returns the directory where the application stores its files.
+func ConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "ghcv-cli"), nil
+}
+
+// LoadConfigJSON reads the JSON file of the name in the config dir into v.
+// A file which does not exist is not an error, and leaves v unchanged.
+// Unknown fields are an error, so that typos in the files edited by hand are reported.
+func LoadConfigJSON(name string, v any) error {
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return nil
+}
+
+// StoreConfigJSON writes v to the JSON file of the name in the config dir, creating the dir if needed.
+func StoreConfigJSON(name string, v any) error {
+	dir, err := ConfigDir()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0666)
+}
@@ -0,0 +1,82 @@
+package ghcv
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type testConfig struct {
+	Name  string   `json:"name"`
+	Items []string `json:"items,omitempty"`
+}
+
+func TestConfigJSON(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	want := &testConfig{Name: "foo", Items: []string{"bar", "baz"}}
+	if err := StoreConfigJSON("sub/test.json", want); err != nil {
+		t.Fatal(err)
+	}
+	got := &testConfig{}
+	if err := LoadConfigJSON("sub/test.json", got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	// the file which does not exist leaves the value
+	got = &testConfig{Name: "default"}
+	if err := LoadConfigJSON("missing.json", got); err != nil {
+		t.Fatal(err)
+	}
+	if want := (&testConfig{Name: "default"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("missing file, got: %v, want: %v", got, want)
+	}
+}
+
+func TestLoadConfigJSONError(t *testing.T) {
+	tests := []struct {
+		data string
+	}{
+		{
+			data: `{"name": "foo"`,
+		},
+		{
+			data: `{"name": 1}`,
+		},
+		{
+			data: `{"name": "foo", "itmes": ["bar"]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Setenv("HOME", t.TempDir())
+		dir, err := ConfigDir()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "test.json"), []byte(test.data), 0666); err != nil {
+			t.Fatal(err)
+		}
+		var got testConfig
+		if err := LoadConfigJSON("test.json", &got); err == nil {
+			t.Errorf("data: %s, want error", test.data)
+		}
+	}
+}
+
+func TestStoreConfigJSONError(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	// the config dir cannot be created where a file is
+	if err := os.WriteFile(filepath.Join(home, ".config"), nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := StoreConfigJSON("test.json", &testConfig{Name: "foo"}); err == nil {
+		t.Errorf("want error")
+	}
+}
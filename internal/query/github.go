@@ -0,0 +1,77 @@
+package query
+
+import (
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+// RepositorySchema accepts queries like `stars:>50 lang:Go pushed:>2024-01-01 -archived`.
+var RepositorySchema = Schema[*gh.UserRepository]{
+	Fields: map[string]Field[*gh.UserRepository]{
+		"name":     TextField(func(r *gh.UserRepository) string { return r.Name }),
+		"lang":     StringField(func(r *gh.UserRepository) string { return r.LangName }),
+		"language": StringField(func(r *gh.UserRepository) string { return r.LangName }),
+		"license":  StringField(func(r *gh.UserRepository) string { return r.License }),
+		"stars":    IntField(func(r *gh.UserRepository) int { return r.Stars }),
+		"forks":    IntField(func(r *gh.UserRepository) int { return r.Forks }),
+		"watchers": IntField(func(r *gh.UserRepository) int { return r.Watchers }),
+		"issues":   IntField(func(r *gh.UserRepository) int { return r.OpenedIssues }),
+		"prs":      IntField(func(r *gh.UserRepository) int { return r.OpenedPullRequests }),
+		"created":  DateField(func(r *gh.UserRepository) time.Time { return r.CreatedAt }),
+		"pushed":   DateField(func(r *gh.UserRepository) time.Time { return r.PushedAt }),
+		"updated":  DateField(func(r *gh.UserRepository) time.Time { return r.PushedAt }),
+		"archived": BoolField(func(r *gh.UserRepository) bool { return r.Archived }),
+	},
+	Text: func(r *gh.UserRepository) string {
+		return r.Name + " " + r.Description
+	},
+}
+
+// PullRequest is a pull request together with the repository it was sent to.
+type PullRequest struct {
+	Owner      string
+	Repository *gh.UserPullRequestsRepository
+	*gh.UserPullRequestsPullRequest
+}
+
+// PullRequests flattens the pull requests grouped by owner and repository.
+func PullRequests(prs *gh.UserPullRequests) []*PullRequest {
+	ret := make([]*PullRequest, 0)
+	for _, owner := range prs.Owners {
+		for _, repo := range owner.Repositories {
+			for _, pr := range repo.PullRequests {
+				ret = append(ret, &PullRequest{
+					Owner:                       owner.Name,
+					Repository:                  repo,
+					UserPullRequestsPullRequest: pr,
+				})
+			}
+		}
+	}
+	return ret
+}
+
+// PullRequestSchema accepts queries like `status:merged owner:kubernetes additions:>100 created:2023`.
+var PullRequestSchema = Schema[*PullRequest]{
+	Fields: map[string]Field[*PullRequest]{
+		"status":    StringField(func(p *PullRequest) string { return p.State }),
+		"state":     StringField(func(p *PullRequest) string { return p.State }),
+		"owner":     StringField(func(p *PullRequest) string { return p.Owner }),
+		"repo":      StringField(func(p *PullRequest) string { return p.Repository.Name }),
+		"title":     TextField(func(p *PullRequest) string { return p.Title }),
+		"lang":      StringField(func(p *PullRequest) string { return p.Repository.LangName }),
+		"language":  StringField(func(p *PullRequest) string { return p.Repository.LangName }),
+		"additions": IntField(func(p *PullRequest) int { return p.Additions }),
+		"deletions": IntField(func(p *PullRequest) int { return p.Deletions }),
+		"changes":   IntField(func(p *PullRequest) int { return p.Additions + p.Deletions }),
+		"stars":     IntField(func(p *PullRequest) int { return p.Repository.Stars }),
+		"created":   DateField(func(p *PullRequest) time.Time { return p.CretaedAt }),
+		"closed":    DateField(func(p *PullRequest) time.Time { return p.ClosedAt }),
+		"open":      BoolField(func(p *PullRequest) bool { return p.State == "OPEN" }),
+		"merged":    BoolField(func(p *PullRequest) bool { return p.State == "MERGED" }),
+	},
+	Text: func(p *PullRequest) string {
+		return p.Owner + "/" + p.Repository.Name + " " + p.Title
+	},
+}
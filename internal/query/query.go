@@ -0,0 +1,127 @@
+// Package query parses filter expressions such as `stars:>50 lang:Go -archived`
+// and compiles them into predicates.
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type Op int
+
+const (
+	OpEq Op = iota
+	OpGt
+	OpGe
+	OpLt
+	OpLe
+	OpRange
+)
+
+// Term is a single element of a query.
+// Key is empty for free text.
+type Term struct {
+	Key    string
+	Op     Op
+	Value  string
+	Upper  string // only for OpRange
+	Negate bool
+}
+
+type Query struct {
+	Terms []*Term
+}
+
+func (q *Query) Empty() bool {
+	return q == nil || len(q.Terms) == 0
+}
+
+// Parse parses the query string.
+//
+//	stars:>50 stars:10..20 lang:Go pushed:>2024-01-01 created:2023 -archived "free text"
+func Parse(s string) (*Query, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]*Term, 0, len(tokens))
+	for _, token := range tokens {
+		term, err := parseTerm(token)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return &Query{Terms: terms}, nil
+}
+
+// tokenize splits the query by the spaces outside the quotes, which are left in the tokens for parseTerm.
+func tokenize(s string) ([]string, error) {
+	tokens := make([]string, 0)
+	var sb strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			sb.WriteRune(r)
+		case unicode.IsSpace(r) && !quoted:
+			if sb.Len() > 0 {
+				tokens = append(tokens, sb.String())
+				sb.Reset()
+			}
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unclosed quote")
+	}
+	if sb.Len() > 0 {
+		tokens = append(tokens, sb.String())
+	}
+	return tokens, nil
+}
+
+func parseTerm(token string) (*Term, error) {
+	term := &Term{}
+	if len(token) > 1 && strings.HasPrefix(token, "-") {
+		term.Negate = true
+		token = token[1:]
+	}
+	// a colon inside the quotes is a part of the free text, such as "foo:bar"
+	key, value, found := strings.Cut(token, ":")
+	if !found || key == "" || strings.Contains(key, `"`) {
+		term.Value = unquote(token)
+		return term, nil
+	}
+	value = unquote(value)
+	if value == "" {
+		return nil, fmt.Errorf("missing value for %q", key)
+	}
+	term.Key = strings.ToLower(key)
+	switch {
+	case strings.HasPrefix(value, ">="):
+		term.Op, term.Value = OpGe, value[2:]
+	case strings.HasPrefix(value, "<="):
+		term.Op, term.Value = OpLe, value[2:]
+	case strings.HasPrefix(value, ">"):
+		term.Op, term.Value = OpGt, value[1:]
+	case strings.HasPrefix(value, "<"):
+		term.Op, term.Value = OpLt, value[1:]
+	case strings.Contains(value, ".."):
+		lower, upper, _ := strings.Cut(value, "..")
+		term.Op, term.Value, term.Upper = OpRange, lower, upper
+	default:
+		term.Op, term.Value = OpEq, value
+	}
+	if term.Value == "" && term.Op != OpRange {
+		return nil, fmt.Errorf("missing value for %q", key)
+	}
+	return term, nil
+}
+
+func unquote(s string) string {
+	return strings.ReplaceAll(s, `"`, "")
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		q    string
+		want []*Term
+	}{
+		{
+			q: `stars:>50 lang:Go -archived`,
+			want: []*Term{
+				{Key: "stars", Op: OpGt, Value: "50"},
+				{Key: "lang", Op: OpEq, Value: "Go"},
+				{Value: "archived", Negate: true},
+			},
+		},
+		{
+			q: `stars:10..* created:<=2023-05 title:"fix bug"`,
+			want: []*Term{
+				{Key: "stars", Op: OpRange, Value: "10", Upper: "*"},
+				{Key: "created", Op: OpLe, Value: "2023-05"},
+				{Key: "title", Op: OpEq, Value: "fix bug"},
+			},
+		},
+		{
+			q: `"foo:bar" -"a b:c" title:"x:y" "lang":Go`,
+			want: []*Term{
+				{Value: "foo:bar"},
+				{Value: "a b:c", Negate: true},
+				{Key: "title", Op: OpEq, Value: "x:y"},
+				{Value: "lang:Go"},
+			},
+		},
+	}
+	for _, test := range tests {
+		got, err := Parse(test.q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if notEqual(got.Terms, test.want) {
+			t.Errorf("q: %s, got: %v, want: %v", test.q, got.Terms, test.want)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, q := range []string{`stars:`, `title:"foo`, `stars:>`} {
+		if _, err := Parse(q); err == nil {
+			t.Errorf("q: %s, want error", q)
+		}
+	}
+}
+
+func TestCompileRepository(t *testing.T) {
+	repos := []*gh.UserRepository{
+		{Name: "foo", LangName: "Go", Stars: 100, PushedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)},
+		{Name: "bar", LangName: "Go", Stars: 10, PushedAt: time.Date(2023, 12, 31, 0, 0, 0, 0, time.Local), Archived: true},
+		{Name: "baz", LangName: "Rust", Stars: 300, Description: "foo clone"},
+	}
+	tests := []struct {
+		q    string
+		want []string
+	}{
+		{q: `stars:>50`, want: []string{"foo", "baz"}},
+		{q: `lang:go`, want: []string{"foo", "bar"}},
+		{q: `-archived lang:Go`, want: []string{"foo"}},
+		{q: `is:archived`, want: []string{"bar"}},
+		{q: `pushed:2023`, want: []string{"bar"}},
+		{q: `pushed:>=2024-01-01`, want: []string{"foo"}},
+		{q: `stars:10..100`, want: []string{"foo", "bar"}},
+		{q: `foo`, want: []string{"foo", "baz"}},
+	}
+	for _, test := range tests {
+		q, err := Parse(test.q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		pred, err := Compile(q, RepositorySchema)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := make([]string, 0)
+		for _, r := range repos {
+			if pred(r) {
+				got = append(got, r.Name)
+			}
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("q: %s, got: %v, want: %v", test.q, got, test.want)
+		}
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	for _, s := range []string{`foo:bar`, `stars:many`, `pushed:yesterday`, `lang:>Go`, `is:foo`} {
+		q, err := Parse(s)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := Compile(q, RepositorySchema); err == nil {
+			t.Errorf("q: %s, want error", s)
+		}
+	}
+}
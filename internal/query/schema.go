@@ -0,0 +1,235 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Field evaluates a term against a value of T.
+type Field[T any] interface {
+	compile(t *Term) (func(T) bool, error)
+}
+
+// Schema defines the keys available in a query.
+// Free text terms are matched against the string returned by Text.
+type Schema[T any] struct {
+	Fields map[string]Field[T]
+	Text   func(T) string
+}
+
+// Compile builds a predicate that is satisfied when all terms of the query match.
+func Compile[T any](q *Query, s Schema[T]) (func(T) bool, error) {
+	preds := make([]func(T) bool, 0)
+	if q != nil {
+		for _, term := range q.Terms {
+			pred, err := s.compileTerm(term)
+			if err != nil {
+				return nil, err
+			}
+			if term.Negate {
+				p := pred
+				pred = func(v T) bool { return !p(v) }
+			}
+			preds = append(preds, pred)
+		}
+	}
+	return func(v T) bool {
+		for _, pred := range preds {
+			if !pred(v) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func (s Schema[T]) compileTerm(term *Term) (func(T) bool, error) {
+	if term.Key == "" {
+		// a bare word that names a flag, e.g. `archived`
+		if f, ok := s.Fields[strings.ToLower(term.Value)].(boolField[T]); ok {
+			return f.get, nil
+		}
+		text := strings.ToLower(term.Value)
+		return func(v T) bool {
+			return strings.Contains(strings.ToLower(s.Text(v)), text)
+		}, nil
+	}
+	if term.Key == "is" {
+		if f, ok := s.Fields[strings.ToLower(term.Value)].(boolField[T]); ok && term.Op == OpEq {
+			return f.get, nil
+		}
+		return nil, fmt.Errorf("unknown flag %q", term.Value)
+	}
+	f, ok := s.Fields[term.Key]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", term.Key)
+	}
+	return f.compile(term)
+}
+
+type intField[T any] struct {
+	get func(T) int
+}
+
+func IntField[T any](get func(T) int) Field[T] {
+	return intField[T]{get}
+}
+
+func (f intField[T]) compile(t *Term) (func(T) bool, error) {
+	parse := func(s, bound string) (int, error) {
+		if s == "" || s == "*" {
+			if bound == "lower" {
+				return minInt, nil
+			}
+			return maxInt, nil
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q for %q", s, t.Key)
+		}
+		return n, nil
+	}
+	n, err := parse(t.Value, "lower")
+	if err != nil {
+		return nil, err
+	}
+	switch t.Op {
+	case OpGt:
+		return func(v T) bool { return f.get(v) > n }, nil
+	case OpGe:
+		return func(v T) bool { return f.get(v) >= n }, nil
+	case OpLt:
+		return func(v T) bool { return f.get(v) < n }, nil
+	case OpLe:
+		return func(v T) bool { return f.get(v) <= n }, nil
+	case OpRange:
+		m, err := parse(t.Upper, "upper")
+		if err != nil {
+			return nil, err
+		}
+		return func(v T) bool { x := f.get(v); return n <= x && x <= m }, nil
+	default:
+		return func(v T) bool { return f.get(v) == n }, nil
+	}
+}
+
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+type dateField[T any] struct {
+	get func(T) time.Time
+}
+
+// DateField matches dates written as 2024, 2024-01 or 2024-01-02.
+// A date covers the whole period, so `created:2023` matches anything created in 2023.
+// Zero times never match.
+func DateField[T any](get func(T) time.Time) Field[T] {
+	return dateField[T]{get}
+}
+
+func (f dateField[T]) compile(t *Term) (func(T) bool, error) {
+	parse := func(s string) (time.Time, time.Time, error) {
+		if s == "" || s == "*" {
+			return time.Time{}, time.Time{}, nil
+		}
+		start, end, err := parsePeriod(s)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q for %q", s, t.Key)
+		}
+		return start, end, nil
+	}
+	start, end, err := parse(t.Value)
+	if err != nil {
+		return nil, err
+	}
+	var match func(time.Time) bool
+	switch t.Op {
+	case OpGt:
+		match = func(x time.Time) bool { return !x.Before(end) }
+	case OpGe:
+		match = func(x time.Time) bool { return !x.Before(start) }
+	case OpLt:
+		match = func(x time.Time) bool { return x.Before(start) }
+	case OpLe:
+		match = func(x time.Time) bool { return x.Before(end) }
+	case OpRange:
+		_, upperEnd, err := parse(t.Upper)
+		if err != nil {
+			return nil, err
+		}
+		match = func(x time.Time) bool {
+			return (start.IsZero() || !x.Before(start)) && (upperEnd.IsZero() || x.Before(upperEnd))
+		}
+	default:
+		match = func(x time.Time) bool { return !x.Before(start) && x.Before(end) }
+	}
+	return func(v T) bool {
+		x := f.get(v)
+		return !x.IsZero() && match(x)
+	}, nil
+}
+
+// parsePeriod returns the half-open interval [start, end) represented by s.
+func parsePeriod(s string) (time.Time, time.Time, error) {
+	layouts := []struct {
+		layout string
+		next   func(time.Time) time.Time
+	}{
+		{"2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+		{"2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+		{"2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+	}
+	for _, l := range layouts {
+		if t, err := time.ParseInLocation(l.layout, s, time.Local); err == nil {
+			return t, l.next(t), nil
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("invalid date: %s", s)
+}
+
+type stringField[T any] struct {
+	get      func(T) string
+	contains bool
+}
+
+// StringField matches the whole value, ignoring case.
+func StringField[T any](get func(T) string) Field[T] {
+	return stringField[T]{get, false}
+}
+
+// TextField matches when the value contains the term, ignoring case.
+func TextField[T any](get func(T) string) Field[T] {
+	return stringField[T]{get, true}
+}
+
+func (f stringField[T]) compile(t *Term) (func(T) bool, error) {
+	if t.Op != OpEq {
+		return nil, fmt.Errorf("%q does not support comparison", t.Key)
+	}
+	value := strings.ToLower(t.Value)
+	if f.contains {
+		return func(v T) bool { return strings.Contains(strings.ToLower(f.get(v)), value) }, nil
+	}
+	return func(v T) bool { return strings.ToLower(f.get(v)) == value }, nil
+}
+
+type boolField[T any] struct {
+	get func(T) bool
+}
+
+// BoolField can be written as a bare word (`archived`, `-archived`), `is:archived` or `archived:false`.
+func BoolField[T any](get func(T) bool) Field[T] {
+	return boolField[T]{get}
+}
+
+func (f boolField[T]) compile(t *Term) (func(T) bool, error) {
+	b, err := strconv.ParseBool(t.Value)
+	if err != nil || t.Op != OpEq {
+		return nil, fmt.Errorf("invalid value %q for %q", t.Value, t.Key)
+	}
+	return func(v T) bool { return f.get(v) == b }, nil
+}
@@ -0,0 +1,203 @@
+// Package report builds a combined report of many users.
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/stats"
+)
+
+const (
+	// the number of repositories in User.TopRepositories
+	topRepositoriesCount = 3
+)
+
+// Formats are the names of the supported output formats.
+var Formats = []string{"md", "csv", "json"}
+
+type Repository struct {
+	Name     string `json:"name"`
+	Language string `json:"language,omitempty"`
+	Stars    int    `json:"stars"`
+	Url      string `json:"url"`
+}
+
+// User is a row of the report. If fetching the user failed, only Login and Error are set.
+type User struct {
+	Login     string `json:"login"`
+	Name      string `json:"name,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Location  string `json:"location,omitempty"`
+	Followers int    `json:"followers"`
+	Url       string `json:"url,omitempty"`
+
+	Repositories    int           `json:"repositories"`
+	Stars           int           `json:"stars"`
+	TopRepositories []*Repository `json:"top_repositories"`
+
+	// pull requests to repositories of other owners
+	PullRequests int     `json:"pull_requests"`
+	Merged       int     `json:"merged"`
+	Open         int     `json:"open"`
+	MergeRate    float64 `json:"merge_rate"`
+
+	Error string `json:"error,omitempty"`
+}
+
+func (u *User) failed() bool {
+	return u.Error != ""
+}
+
+// Build makes the row of the user.
+func Build(profile *gh.UserProfile, repos *gh.UserRepositories, prs *gh.UserPullRequests, now time.Time) *User {
+	rs := stats.RepositoryStats(repos, now)
+	ps := stats.PullRequestStats(prs, now)
+
+	sorted := make([]*gh.UserRepository, len(repos.Repositories))
+	copy(sorted, repos.Repositories)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Stars > sorted[j].Stars })
+	top := make([]*Repository, 0, topRepositoriesCount)
+	for _, r := range sorted[:min(len(sorted), topRepositoriesCount)] {
+		top = append(top, &Repository{Name: r.Name, Language: r.LangName, Stars: r.Stars, Url: r.Url})
+	}
+
+	return &User{
+		Login:           profile.Login,
+		Name:            profile.Name,
+		Company:         profile.Company,
+		Location:        profile.Location,
+		Followers:       profile.Followers,
+		Url:             profile.Url,
+		Repositories:    rs.Total,
+		Stars:           rs.Stars,
+		TopRepositories: top,
+		PullRequests:    ps.Total,
+		Merged:          ps.Merged,
+		Open:            ps.Open,
+		MergeRate:       ps.MergeRate(),
+	}
+}
+
+// Collect runs fetch for each login on at most workers goroutines.
+// The returned users are in the order of the logins, and a failed fetch becomes a row with the error.
+func Collect(logins []string, workers int, fetch func(login string) (*User, error)) []*User {
+	users := make([]*User, len(logins))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(workers, 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				u, err := fetch(logins[i])
+				if err != nil {
+					u = &User{Login: logins[i], Error: err.Error()}
+				}
+				users[i] = u
+			}
+		}()
+	}
+	for i := range logins {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return users
+}
+
+// Failed returns the number of the users that could not be fetched.
+func Failed(users []*User) int {
+	n := 0
+	for _, u := range users {
+		if u.failed() {
+			n++
+		}
+	}
+	return n
+}
+
+func Write(w io.Writer, format string, users []*User) error {
+	switch format {
+	case "md":
+		return writeMarkdown(w, users)
+	case "csv":
+		return writeCSV(w, users)
+	case "json":
+		return writeJSON(w, users)
+	}
+	return fmt.Errorf("unknown format: %s (must be one of %s)", format, strings.Join(Formats, ", "))
+}
+
+func mergeRate(u *User) string {
+	if u.PullRequests-u.Open == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", u.MergeRate*100)
+}
+
+func topRepositories(u *User, f func(*Repository) string) string {
+	rs := make([]string, len(u.TopRepositories))
+	for i, r := range u.TopRepositories {
+		rs[i] = f(r)
+	}
+	return strings.Join(rs, ", ")
+}
+
+func writeMarkdown(w io.Writer, users []*User) error {
+	// cells must not break the table
+	cell := strings.NewReplacer("|", "\\|", "\n", " ").Replace
+
+	var b strings.Builder
+	b.WriteString("| Login | Name | Company | Location | Followers | Repositories | Stars | Top repositories | Pull Requests | Merged | Merge rate |\n")
+	b.WriteString("| --- | --- | --- | --- | ---: | ---: | ---: | --- | ---: | ---: | ---: |\n")
+	for _, u := range users {
+		if u.failed() {
+			fmt.Fprintf(&b, "| %s | error: %s | | | | | | | | | |\n", cell(u.Login), cell(u.Error))
+			continue
+		}
+		top := topRepositories(u, func(r *Repository) string {
+			return fmt.Sprintf("[%s](%s) (%d)", cell(r.Name), r.Url, r.Stars)
+		})
+		fmt.Fprintf(&b, "| [%s](%s) | %s | %s | %s | %d | %d | %d | %s | %d | %d | %s |\n",
+			cell(u.Login), u.Url, cell(u.Name), cell(u.Company), cell(u.Location),
+			u.Followers, u.Repositories, u.Stars, top, u.PullRequests, u.Merged, mergeRate(u))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeCSV(w io.Writer, users []*User) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"login", "name", "company", "location", "followers", "repositories", "stars", "top_repositories", "pull_requests", "merged", "open", "merge_rate", "error"})
+	for _, u := range users {
+		if u.failed() {
+			cw.Write([]string{u.Login, "", "", "", "", "", "", "", "", "", "", "", u.Error})
+			continue
+		}
+		top := topRepositories(u, func(r *Repository) string {
+			return fmt.Sprintf("%s (%d)", r.Name, r.Stars)
+		})
+		cw.Write([]string{
+			u.Login, u.Name, u.Company, u.Location,
+			fmt.Sprint(u.Followers), fmt.Sprint(u.Repositories), fmt.Sprint(u.Stars), top,
+			fmt.Sprint(u.PullRequests), fmt.Sprint(u.Merged), fmt.Sprint(u.Open), fmt.Sprintf("%.3f", u.MergeRate),
+			"",
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, users []*User) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(users)
+}
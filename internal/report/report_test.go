@@ -0,0 +1,139 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func TestBuild(t *testing.T) {
+	profile := &gh.UserProfile{Login: "foo", Name: "Foo", Followers: 10, Url: "https://github.com/foo"}
+	repos := &gh.UserRepositories{
+		Repositories: []*gh.UserRepository{
+			{Name: "a", Stars: 1, Url: "https://github.com/foo/a"},
+			{Name: "b", Stars: 30, LangName: "Go", Url: "https://github.com/foo/b"},
+			{Name: "c", Stars: 5, Url: "https://github.com/foo/c"},
+			{Name: "d", Stars: 20, Url: "https://github.com/foo/d"},
+		},
+	}
+	prs := &gh.UserPullRequests{
+		Owners: []*gh.UserPullRequestsOwner{
+			{
+				Name: "bar",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{
+						Name: "x",
+						PullRequests: []*gh.UserPullRequestsPullRequest{
+							{State: "MERGED"}, {State: "MERGED"}, {State: "MERGED"}, {State: "CLOSED"}, {State: "OPEN"},
+						},
+					},
+				},
+			},
+		},
+	}
+	got := Build(profile, repos, prs, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	want := &User{
+		Login:        "foo",
+		Name:         "Foo",
+		Followers:    10,
+		Url:          "https://github.com/foo",
+		Repositories: 4,
+		Stars:        56,
+		TopRepositories: []*Repository{
+			{Name: "b", Language: "Go", Stars: 30, Url: "https://github.com/foo/b"},
+			{Name: "d", Stars: 20, Url: "https://github.com/foo/d"},
+			{Name: "c", Stars: 5, Url: "https://github.com/foo/c"},
+		},
+		PullRequests: 5,
+		Merged:       3,
+		Open:         1,
+		MergeRate:    0.75,
+	}
+	if notEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	logins := []string{"a", "b", "c", "d", "e"}
+	got := Collect(logins, 2, func(login string) (*User, error) {
+		if login == "c" {
+			return nil, errors.New("not found")
+		}
+		return &User{Login: login, Stars: len(login)}, nil
+	})
+	want := []*User{
+		{Login: "a", Stars: 1},
+		{Login: "b", Stars: 1},
+		{Login: "c", Error: "not found"},
+		{Login: "d", Stars: 1},
+		{Login: "e", Stars: 1},
+	}
+	if notEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+	if got := Failed(got); got != 1 {
+		t.Errorf("got: %d, want: %d", got, 1)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	users := []*User{
+		{
+			Login:           "foo",
+			Name:            "Foo | Bar",
+			Url:             "https://github.com/foo",
+			Followers:       10,
+			Repositories:    2,
+			Stars:           35,
+			TopRepositories: []*Repository{{Name: "b", Stars: 30, Url: "https://github.com/foo/b"}, {Name: "c", Stars: 5, Url: "https://github.com/foo/c"}},
+			PullRequests:    5,
+			Merged:          3,
+			Open:            1,
+			MergeRate:       0.75,
+		},
+		{Login: "baz", Error: "not found"},
+	}
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: "md",
+			want: "| Login | Name | Company | Location | Followers | Repositories | Stars | Top repositories | Pull Requests | Merged | Merge rate |\n" +
+				"| --- | --- | --- | --- | ---: | ---: | ---: | --- | ---: | ---: | ---: |\n" +
+				"| [foo](https://github.com/foo) | Foo \\| Bar |  |  | 10 | 2 | 35 | [b](https://github.com/foo/b) (30), [c](https://github.com/foo/c) (5) | 5 | 3 | 75.0% |\n" +
+				"| baz | error: not found | | | | | | | | | |\n",
+		},
+		{
+			format: "csv",
+			want: "login,name,company,location,followers,repositories,stars,top_repositories,pull_requests,merged,open,merge_rate,error\n" +
+				"foo,Foo | Bar,,,10,2,35,\"b (30), c (5)\",5,3,1,0.750,\n" +
+				"baz,,,,,,,,,,,,not found\n",
+		},
+	}
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := Write(&b, test.format, users); err != nil {
+			t.Fatal(err)
+		}
+		if got := b.String(); got != test.want {
+			t.Errorf("%s: got: %q, want: %q", test.format, got, test.want)
+		}
+	}
+	if err := Write(&bytes.Buffer{}, "xml", users); err == nil {
+		t.Errorf("xml: want error")
+	}
+}
@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"sort"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+// SharedRepository is a repository both users created pull requests to.
+type SharedRepository struct {
+	Name string // owner/name
+	A    int    // the number of pull requests of the first user
+	B    int    // the number of pull requests of the second user
+}
+
+// SharedRepositories returns the target repositories both users created pull requests to,
+// in descending order of the total number of pull requests, then in ascending order of the name.
+func SharedRepositories(a, b *gh.UserPullRequests) []*SharedRepository {
+	countsA, countsB := repositoryCounts(a), repositoryCounts(b)
+	ret := make([]*SharedRepository, 0)
+	for name, n := range countsA {
+		if m, ok := countsB[name]; ok {
+			ret = append(ret, &SharedRepository{Name: name, A: n, B: m})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		ti, tj := ret[i].A+ret[i].B, ret[j].A+ret[j].B
+		if ti == tj {
+			return ret[i].Name < ret[j].Name
+		}
+		return ti > tj
+	})
+	return ret
+}
+
+func repositoryCounts(prs *gh.UserPullRequests) counter {
+	c := make(counter)
+	for _, owner := range prs.Owners {
+		for _, repo := range owner.Repositories {
+			c.addN(owner.Name+"/"+repo.Name, len(repo.PullRequests))
+		}
+	}
+	return c
+}
@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func TestSharedRepositories(t *testing.T) {
+	pr := &gh.UserPullRequestsPullRequest{}
+	a := &gh.UserPullRequests{
+		Owners: []*gh.UserPullRequestsOwner{
+			{
+				Name: "foo",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{Name: "a", PullRequests: []*gh.UserPullRequestsPullRequest{pr}},
+					{Name: "b", PullRequests: []*gh.UserPullRequestsPullRequest{pr, pr}},
+					{Name: "c", PullRequests: []*gh.UserPullRequestsPullRequest{pr}},
+				},
+			},
+		},
+	}
+	b := &gh.UserPullRequests{
+		Owners: []*gh.UserPullRequestsOwner{
+			{
+				Name: "foo",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{Name: "a", PullRequests: []*gh.UserPullRequestsPullRequest{pr, pr, pr}},
+					{Name: "b", PullRequests: []*gh.UserPullRequestsPullRequest{pr, pr}},
+				},
+			},
+			{
+				Name: "bar",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{Name: "c", PullRequests: []*gh.UserPullRequestsPullRequest{pr}},
+				},
+			},
+		},
+	}
+	got := SharedRepositories(a, b)
+	want := []*SharedRepository{
+		{Name: "foo/a", A: 1, B: 3},
+		{Name: "foo/b", A: 2, B: 2},
+	}
+	if notEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
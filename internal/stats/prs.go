@@ -0,0 +1,76 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+type PullRequests struct {
+	Total  int
+	Open   int
+	Merged int
+	Closed int // closed without being merged
+
+	Additions int
+	Deletions int
+
+	// zero if there are no such pull requests
+	MedianTimeToMerge time.Duration
+	MedianTimeToClose time.Duration
+
+	PerYear  []Count // every year from the first pull request
+	PerMonth []Count // the last 12 months
+
+	TopOwners       []Count // by merged count
+	TopRepositories []Count // by merged count, as owner/name
+	Languages       []Count // of the target repositories
+}
+
+// MergeRate returns the ratio of merged pull requests to the ones that are no longer open.
+func (s *PullRequests) MergeRate() float64 {
+	if s.Merged+s.Closed == 0 {
+		return 0
+	}
+	return float64(s.Merged) / float64(s.Merged+s.Closed)
+}
+
+func PullRequestStats(prs *gh.UserPullRequests, now time.Time) *PullRequests {
+	s := &PullRequests{}
+	years, months := make(counter), make(counter)
+	owners, repos, langs := make(counter), make(counter), make(counter)
+	toMerge, toClose := make([]time.Duration, 0), make([]time.Duration, 0)
+	for _, owner := range prs.Owners {
+		for _, repo := range owner.Repositories {
+			for _, pr := range repo.PullRequests {
+				s.Total += 1
+				s.Additions += pr.Additions
+				s.Deletions += pr.Deletions
+				years.add(pr.CretaedAt.Format("2006"))
+				months.add(pr.CretaedAt.Format("2006-01"))
+				langs.add(LanguageName(repo.LangName))
+				switch pr.State {
+				case "OPEN":
+					s.Open += 1
+				case "MERGED":
+					s.Merged += 1
+					owners.add(owner.Name)
+					repos.add(owner.Name + "/" + repo.Name)
+					// a merged pull request is closed at the time it is merged
+					toMerge = append(toMerge, pr.ClosedAt.Sub(pr.CretaedAt))
+				case "CLOSED":
+					s.Closed += 1
+					toClose = append(toClose, pr.ClosedAt.Sub(pr.CretaedAt))
+				}
+			}
+		}
+	}
+	s.MedianTimeToMerge = median(toMerge)
+	s.MedianTimeToClose = median(toClose)
+	s.PerYear = years.years()
+	s.PerMonth = months.lastMonths(now, 12)
+	s.TopOwners = owners.sorted()
+	s.TopRepositories = repos.sorted()
+	s.Languages = langs.sorted()
+	return s
+}
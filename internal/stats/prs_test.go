@@ -0,0 +1,82 @@
+package stats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+func TestPullRequestStats(t *testing.T) {
+	prs := &gh.UserPullRequests{
+		Owners: []*gh.UserPullRequestsOwner{
+			{
+				Name: "foo",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{
+						Name:     "a",
+						LangName: "Go",
+						PullRequests: []*gh.UserPullRequestsPullRequest{
+							{State: "MERGED", Additions: 10, Deletions: 1, CretaedAt: date(2022, 5, 1), ClosedAt: date(2022, 5, 3)},
+							{State: "MERGED", Additions: 20, Deletions: 2, CretaedAt: date(2024, 1, 1), ClosedAt: date(2024, 1, 5)},
+							{State: "OPEN", Additions: 30, Deletions: 3, CretaedAt: date(2024, 2, 1)},
+						},
+					},
+				},
+			},
+			{
+				Name: "bar",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{
+						Name: "b",
+						PullRequests: []*gh.UserPullRequestsPullRequest{
+							{State: "MERGED", Additions: 40, Deletions: 4, CretaedAt: date(2024, 2, 10), ClosedAt: date(2024, 2, 11)},
+							{State: "CLOSED", Additions: 50, Deletions: 5, CretaedAt: date(2024, 3, 1), ClosedAt: date(2024, 3, 8)},
+						},
+					},
+				},
+			},
+		},
+	}
+	got := PullRequestStats(prs, date(2024, 3, 20))
+
+	day := 24 * time.Hour
+	want := &PullRequests{
+		Total:             5,
+		Open:              1,
+		Merged:            3,
+		Closed:            1,
+		Additions:         150,
+		Deletions:         15,
+		MedianTimeToMerge: 2 * day,
+		MedianTimeToClose: 7 * day,
+		PerYear:           []Count{{"2022", 1}, {"2023", 0}, {"2024", 4}},
+		TopOwners:         []Count{{"foo", 2}, {"bar", 1}},
+		TopRepositories:   []Count{{"foo/a", 2}, {"bar/b", 1}},
+		Languages:         []Count{{"Go", 3}, {"(none)", 2}},
+	}
+	perMonth := got.PerMonth
+	got.PerMonth = nil
+	if notEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+	if len(perMonth) != 12 || perMonth[0] != (Count{"2023-04", 0}) || perMonth[11] != (Count{"2024-03", 1}) || perMonth[10] != (Count{"2024-02", 2}) {
+		t.Errorf("per month: %v", perMonth)
+	}
+	if got.MergeRate() != 0.75 {
+		t.Errorf("merge rate: %v", got.MergeRate())
+	}
+}
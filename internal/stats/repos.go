@@ -0,0 +1,105 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+const (
+	noLicense = "(none)"
+
+	// repositories not pushed for longer than this are stale
+	staleThreshold = 365 * 24 * time.Hour
+)
+
+// Period is a range of durations, [From, To). To is zero for the last period.
+type Period struct {
+	Name string
+	From time.Duration
+	To   time.Duration
+}
+
+// AgePeriods are the buckets of the age histogram.
+var AgePeriods = []Period{
+	{"< 1 month", 0, 30 * day},
+	{"< 6 months", 30 * day, 182 * day},
+	{"< 1 year", 182 * day, 365 * day},
+	{"< 2 years", 365 * day, 2 * 365 * day},
+	{"< 5 years", 2 * 365 * day, 5 * 365 * day},
+	{"5+ years", 5 * 365 * day, 0},
+}
+
+const day = 24 * time.Hour
+
+func periodIndex(d time.Duration) int {
+	for i, p := range AgePeriods {
+		if p.To == 0 || d < p.To {
+			return i
+		}
+	}
+	return len(AgePeriods) - 1
+}
+
+type Repositories struct {
+	Total    int
+	Stars    int
+	Forks    int
+	Watchers int
+
+	LanguagesByCount []Count
+	LanguagesByStars []Count
+	Licenses         []Count
+
+	// AgeVsPush[i][j] is the number of repositories created AgePeriods[i] ago
+	// and last pushed AgePeriods[j] ago.
+	AgeVsPush [][]int
+
+	// not archived and not pushed for over a year, the least recently pushed first
+	Stale []*gh.UserRepository
+}
+
+// Languages counts the repositories by language.
+func Languages(repos []*gh.UserRepository) []Count {
+	langs := make(counter)
+	for _, repo := range repos {
+		langs.add(LanguageName(repo.LangName))
+	}
+	return langs.sorted()
+}
+
+func RepositoryStats(repos *gh.UserRepositories, now time.Time) *Repositories {
+	s := &Repositories{
+		AgeVsPush: make([][]int, len(AgePeriods)),
+		Stale:     make([]*gh.UserRepository, 0),
+	}
+	for i := range s.AgeVsPush {
+		s.AgeVsPush[i] = make([]int, len(AgePeriods))
+	}
+	langStars, licenses := make(counter), make(counter)
+	for _, repo := range repos.Repositories {
+		s.Total += 1
+		s.Stars += repo.Stars
+		s.Forks += repo.Forks
+		s.Watchers += repo.Watchers
+		langStars.addN(LanguageName(repo.LangName), repo.Stars)
+		license := repo.License
+		if license == "" {
+			license = noLicense
+		}
+		licenses.add(license)
+		sincePush := now.Sub(repo.PushedAt)
+		s.AgeVsPush[periodIndex(now.Sub(repo.CreatedAt))][periodIndex(sincePush)] += 1
+		if !repo.Archived && sincePush > staleThreshold {
+			s.Stale = append(s.Stale, repo)
+		}
+	}
+	s.LanguagesByCount = Languages(repos.Repositories)
+	s.LanguagesByStars = langStars.sorted()
+	s.Licenses = licenses.sorted()
+	sort.SliceStable(s.Stale, func(i, j int) bool {
+		return s.Stale[i].PushedAt.Before(s.Stale[j].PushedAt)
+	})
+	return s
+}
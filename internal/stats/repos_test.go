@@ -0,0 +1,43 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func TestRepositoryStats(t *testing.T) {
+	repos := &gh.UserRepositories{
+		Repositories: []*gh.UserRepository{
+			{Name: "a", LangName: "Go", License: "MIT", Stars: 5, Forks: 1, CreatedAt: date(2018, 1, 1), PushedAt: date(2024, 3, 1)},
+			{Name: "b", LangName: "Go", Stars: 1, Watchers: 2, CreatedAt: date(2022, 1, 1), PushedAt: date(2022, 6, 1)},
+			{Name: "c", LangName: "Rust", License: "MIT", Stars: 10, CreatedAt: date(2023, 12, 1), PushedAt: date(2023, 1, 1), Archived: true},
+			{Name: "d", License: "Apache-2.0", CreatedAt: date(2020, 1, 1), PushedAt: date(2021, 1, 1)},
+		},
+	}
+	got := RepositoryStats(repos, date(2024, 3, 20))
+
+	if got.Total != 4 || got.Stars != 16 || got.Forks != 1 || got.Watchers != 2 {
+		t.Errorf("totals: %+v", got)
+	}
+	if want := []Count{{"Go", 2}, {"(none)", 1}, {"Rust", 1}}; notEqual(got.LanguagesByCount, want) {
+		t.Errorf("got: %v, want: %v", got.LanguagesByCount, want)
+	}
+	if want := []Count{{"Rust", 10}, {"Go", 6}, {"(none)", 0}}; notEqual(got.LanguagesByStars, want) {
+		t.Errorf("got: %v, want: %v", got.LanguagesByStars, want)
+	}
+	if want := []Count{{"MIT", 2}, {"(none)", 1}, {"Apache-2.0", 1}}; notEqual(got.Licenses, want) {
+		t.Errorf("got: %v, want: %v", got.Licenses, want)
+	}
+	// a: 5+ years old, pushed < 1 month ago
+	if got.AgeVsPush[5][0] != 1 {
+		t.Errorf("age vs push: %v", got.AgeVsPush)
+	}
+	stale := make([]string, 0)
+	for _, r := range got.Stale {
+		stale = append(stale, r.Name)
+	}
+	if want := []string{"d", "b"}; notEqual(stale, want) {
+		t.Errorf("got: %v, want: %v", stale, want)
+	}
+}
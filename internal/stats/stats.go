@@ -0,0 +1,105 @@
+// Package stats aggregates the data fetched by the gh package.
+package stats
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	// the name of the language of repositories without a language
+	NoLanguage = "(none)"
+)
+
+// Count is a number of items in a group, such as a year, an owner or a language.
+type Count struct {
+	Name  string
+	Count int
+}
+
+// counter counts items by group name.
+type counter map[string]int
+
+func (c counter) add(name string) {
+	c[name] += 1
+}
+
+func (c counter) addN(name string, n int) {
+	c[name] += n
+}
+
+// sorted returns the groups in descending order of the count, then in ascending order of the name.
+func (c counter) sorted() []Count {
+	ret := make([]Count, 0, len(c))
+	for name, n := range c {
+		ret = append(ret, Count{Name: name, Count: n})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count == ret[j].Count {
+			return ret[i].Name < ret[j].Name
+		}
+		return ret[i].Count > ret[j].Count
+	})
+	return ret
+}
+
+// years returns the count of every year from the first to the last year with items.
+// The names must be years.
+func (c counter) years() []Count {
+	ret := make([]Count, 0)
+	first, last := 0, 0
+	for name := range c {
+		y, err := strconv.Atoi(name)
+		if err != nil {
+			continue
+		}
+		if first == 0 || y < first {
+			first = y
+		}
+		if y > last {
+			last = y
+		}
+	}
+	if first == 0 {
+		return ret
+	}
+	for y := first; y <= last; y++ {
+		name := strconv.Itoa(y)
+		ret = append(ret, Count{Name: name, Count: c[name]})
+	}
+	return ret
+}
+
+// lastMonths returns the count of the n months up to the month of now.
+func (c counter) lastMonths(now time.Time, n int) []Count {
+	ret := make([]Count, n)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	for i := 0; i < n; i++ {
+		name := month.AddDate(0, i-n+1, 0).Format("2006-01")
+		ret[i] = Count{Name: name, Count: c[name]}
+	}
+	return ret
+}
+
+// LanguageName returns the name used to group the language, NoLanguage for the empty name.
+func LanguageName(name string) string {
+	if name == "" {
+		return NoLanguage
+	}
+	return name
+}
+
+func median(ds []time.Duration) time.Duration {
+	if len(ds) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(ds))
+	copy(sorted, ds)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
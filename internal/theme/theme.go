@@ -0,0 +1,321 @@
+package theme
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+	"github.com/muesli/termenv"
+)
+
+const (
+	themeEnvKey   = "GHCV_THEME"
+	noColorEnvKey = "NO_COLOR"
+
+	defaultThemeName = "auto"
+	monoThemeName    = "mono"
+)
+
+// Role is the role of a color in the user interface.
+type Role string
+
+const (
+	TitleBackground     Role = "title_background"
+	TitleText           Role = "title_text"
+	Text                Role = "text"
+	SubText             Role = "sub_text"
+	Muted               Role = "muted"
+	Dimmed              Role = "dimmed"
+	Selected            Role = "selected"
+	SelectedSub         Role = "selected_sub"
+	Error               Role = "error"
+	Link                Role = "link"
+	Open                Role = "open"
+	Merged              Role = "merged"
+	Closed              Role = "closed"
+	Added               Role = "added"
+	Deleted             Role = "deleted"
+	Favorite            Role = "favorite"
+	HighlightBackground Role = "highlight_background"
+	HighlightText       Role = "highlight_text"
+	AvatarText          Role = "avatar_text"
+)
+
+// Palette maps the roles to the colors, in ANSI 256 color numbers or hex codes.
+type Palette map[Role]string
+
+var darkPalette = Palette{
+	TitleBackground:     "97",
+	TitleText:           "229",
+	Text:                "#dddddd",
+	SubText:             "#777777",
+	Muted:               "240",
+	Dimmed:              "245",
+	Selected:            "142",
+	SelectedSub:         "143",
+	Error:               "161",
+	Link:                "33",
+	Open:                "34",
+	Merged:              "98",
+	Closed:              "203",
+	Added:               "34",
+	Deleted:             "203",
+	Favorite:            "220",
+	HighlightBackground: "250",
+	HighlightText:       "56",
+	AvatarText:          "255",
+}
+
+var lightPalette = Palette{
+	TitleBackground:     "97",
+	TitleText:           "229",
+	Text:                "#1a1a1a",
+	SubText:             "243",
+	Muted:               "241",
+	Dimmed:              "243",
+	Selected:            "64",
+	SelectedSub:         "100",
+	Error:               "161",
+	Link:                "26",
+	Open:                "28",
+	Merged:              "91",
+	Closed:              "160",
+	Added:               "28",
+	Deleted:             "160",
+	Favorite:            "172",
+	HighlightBackground: "153",
+	HighlightText:       "17",
+	AvatarText:          "255",
+}
+
+var highContrastPalette = Palette{
+	TitleBackground:     "15",
+	TitleText:           "0",
+	Text:                "15",
+	SubText:             "252",
+	Muted:               "250",
+	Dimmed:              "252",
+	Selected:            "11",
+	SelectedSub:         "14",
+	Error:               "9",
+	Link:                "12",
+	Open:                "10",
+	Merged:              "13",
+	Closed:              "9",
+	Added:               "10",
+	Deleted:             "9",
+	Favorite:            "11",
+	HighlightBackground: "11",
+	HighlightText:       "0",
+	AvatarText:          "15",
+}
+
+// the backgrounds of the avatars, with white text
+var defaultAccents = []string{"62", "99", "133", "166", "31", "28", "130", "90"}
+
+// Theme is the colors of the user interface.
+type Theme struct {
+	Name string
+	// no colors are used, and the emphasis is shown by the text attributes instead
+	Monochrome bool
+
+	colors  map[Role]lipgloss.TerminalColor
+	accents []lipgloss.TerminalColor
+}
+
+// Color returns the color of the role, or no color if the theme does not define it.
+func (t *Theme) Color(r Role) lipgloss.TerminalColor {
+	if c, ok := t.colors[r]; ok {
+		return c
+	}
+	return lipgloss.NoColor{}
+}
+
+// Accent returns one of the accent colors, which are used to tell items apart, such as avatars.
+func (t *Theme) Accent(i int) lipgloss.TerminalColor {
+	if len(t.accents) == 0 {
+		return lipgloss.NoColor{}
+	}
+	n := len(t.accents)
+	return t.accents[(i%n+n)%n]
+}
+
+func newTheme(name string, p Palette) *Theme {
+	t := &Theme{
+		Name:   name,
+		colors: make(map[Role]lipgloss.TerminalColor),
+	}
+	for r, c := range p {
+		t.colors[r] = lipgloss.Color(c)
+	}
+	for _, c := range defaultAccents {
+		t.accents = append(t.accents, lipgloss.Color(c))
+	}
+	return t
+}
+
+// newAdaptiveTheme chooses the light or the dark colors depending on the background of the terminal.
+func newAdaptiveTheme(name string, light, dark Palette) *Theme {
+	t := newTheme(name, nil)
+	for r := range dark {
+		t.colors[r] = lipgloss.AdaptiveColor{Light: light[r], Dark: dark[r]}
+	}
+	return t
+}
+
+func builtin(name string) (*Theme, bool) {
+	switch name {
+	case "auto":
+		return newAdaptiveTheme(name, lightPalette, darkPalette), true
+	case "dark":
+		return newTheme(name, darkPalette), true
+	case "light":
+		return newTheme(name, lightPalette), true
+	case "high-contrast":
+		return newTheme(name, highContrastPalette), true
+	case monoThemeName:
+		return &Theme{Name: name, Monochrome: true}, true
+	}
+	return nil, false
+}
+
+// themeFile is a theme defined by the user, which overrides the colors of a built-in theme.
+type themeFile struct {
+	Base    string            `json:"base"`
+	Colors  map[string]string `json:"colors"`
+	Accents []string          `json:"accents"`
+}
+
+var colorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func validColor(s string) bool {
+	if n, err := strconv.Atoi(s); err == nil {
+		return 0 <= n && n <= 255
+	}
+	return colorPattern.MatchString(s)
+}
+
+func knownRole(r Role) bool {
+	_, ok := darkPalette[r]
+	return ok
+}
+
+func themeOfFile(name string, f *themeFile) (*Theme, error) {
+	base := f.Base
+	if base == "" {
+		base = defaultThemeName
+	}
+	t, ok := builtin(base)
+	if !ok {
+		return nil, fmt.Errorf("unknown base theme: %s", base)
+	}
+	if t.Monochrome {
+		return nil, fmt.Errorf("cannot override the colors of %s", base)
+	}
+	t.Name = name
+	for k, c := range f.Colors {
+		r := Role(k)
+		if !knownRole(r) {
+			return nil, fmt.Errorf("unknown color name: %s", k)
+		}
+		if !validColor(c) {
+			return nil, fmt.Errorf("invalid color of %s: %s", k, c)
+		}
+		t.colors[r] = lipgloss.Color(c)
+	}
+	if len(f.Accents) > 0 {
+		t.accents = nil
+		for _, c := range f.Accents {
+			if !validColor(c) {
+				return nil, fmt.Errorf("invalid accent color: %s", c)
+			}
+			t.accents = append(t.accents, lipgloss.Color(c))
+		}
+	}
+	return t, nil
+}
+
+func themeFileName(name string) string {
+	return filepath.Join("themes", name+".json")
+}
+
+// Load returns the built-in theme of the name, or the theme defined in the file of the name in the config dir.
+// The monochrome theme is returned regardless of the name if NO_COLOR is set.
+func Load(name string) (*Theme, error) {
+	if os.Getenv(noColorEnvKey) != "" {
+		name = monoThemeName
+	}
+	if name == "" {
+		name = defaultThemeName
+	}
+	if t, ok := builtin(name); ok {
+		return t, nil
+	}
+	// nil if the file does not exist
+	var f *themeFile
+	if err := ghcv.LoadConfigJSON(themeFileName(name), &f); err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, fmt.Errorf("unknown theme: %s", name)
+	}
+	t, err := themeOfFile(name, f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", themeFileName(name), err)
+	}
+	return t, nil
+}
+
+var (
+	current *Theme
+	loadErr error
+)
+
+// The theme is loaded before the styles of the user interface are built.
+func init() {
+	current, loadErr = Load(os.Getenv(themeEnvKey))
+	if loadErr != nil {
+		current, _ = Load("")
+	}
+	if current.Monochrome {
+		// the colors of the components are also removed
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+}
+
+// Current returns the theme in use.
+func Current() *Theme {
+	return current
+}
+
+// Err returns the error in loading the theme chosen by the user. The default theme is used instead in that case.
+func Err() error {
+	return loadErr
+}
+
+// Monochrome reports whether the theme in use has no colors.
+func Monochrome() bool {
+	return current.Monochrome
+}
+
+// Color returns the color of the role in the theme in use.
+func (r Role) Color() lipgloss.TerminalColor {
+	return current.Color(r)
+}
+
+// Accent returns one of the accent colors of the theme in use.
+func Accent(i int) lipgloss.TerminalColor {
+	return current.Accent(i)
+}
+
+// Language returns the color of a programming language given by GitHub in hex.
+func Language(hex string) lipgloss.TerminalColor {
+	if current.Monochrome || hex == "" {
+		return lipgloss.NoColor{}
+	}
+	return lipgloss.Color(hex)
+}
@@ -0,0 +1,133 @@
+package theme
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func testThemeOfFile(data string) (*Theme, error) {
+	var f themeFile
+	if err := json.Unmarshal([]byte(data), &f); err != nil {
+		return nil, err
+	}
+	return themeOfFile("custom", &f)
+}
+
+func TestThemeOfFile(t *testing.T) {
+	tests := []struct {
+		data string
+		role Role
+		want lipgloss.TerminalColor
+	}{
+		{
+			data: `{"base": "dark", "colors": {"selected": "#ffaf00"}}`,
+			role: Selected,
+			want: lipgloss.Color("#ffaf00"),
+		},
+		{
+			data: `{"base": "dark", "colors": {"selected": "#ffaf00"}}`,
+			role: Error,
+			want: lipgloss.Color("161"),
+		},
+		{
+			data: `{"base": "light"}`,
+			role: Favorite,
+			want: lipgloss.Color("172"),
+		},
+		{
+			data: `{"colors": {"link": "39"}}`,
+			role: Link,
+			want: lipgloss.Color("39"),
+		},
+		{
+			data: `{"colors": {"link": "39"}}`,
+			role: Text,
+			want: lipgloss.AdaptiveColor{Light: "#1a1a1a", Dark: "#dddddd"},
+		},
+	}
+	for _, test := range tests {
+		got, err := testThemeOfFile(test.data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if notEqual(got.Color(test.role), test.want) {
+			t.Errorf("data: %s, role: %s, got: %v, want: %v", test.data, test.role, got.Color(test.role), test.want)
+		}
+	}
+
+	for _, data := range []string{
+		`{"base": "solarized"}`,
+		`{"base": "mono", "colors": {"link": "39"}}`,
+		`{"colors": {"unknown": "39"}}`,
+		`{"colors": {"link": "256"}}`,
+		`{"colors": {"link": "blue"}}`,
+		`{"accents": ["#12345"]}`,
+	} {
+		if _, err := testThemeOfFile(data); err == nil {
+			t.Errorf("data: %s, want error", data)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		noColor string
+		want    string
+		mono    bool
+	}{
+		{name: "", want: "auto"},
+		{name: "light", want: "light"},
+		{name: "high-contrast", want: "high-contrast"},
+		{name: "mono", want: "mono", mono: true},
+		{name: "dark", noColor: "1", want: "mono", mono: true},
+	}
+	for _, test := range tests {
+		t.Setenv(noColorEnvKey, test.noColor)
+		got, err := Load(test.name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Name != test.want || got.Monochrome != test.mono {
+			t.Errorf("name: %s, NO_COLOR: %s, got: %s (%v), want: %s (%v)", test.name, test.noColor, got.Name, got.Monochrome, test.want, test.mono)
+		}
+	}
+
+	t.Setenv(noColorEnvKey, "")
+	t.Setenv("HOME", t.TempDir())
+	if _, err := Load("missing"); err == nil {
+		t.Errorf("want error for missing theme file")
+	}
+
+	dir, err := ghcv.ConfigDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "themes"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "themes", "custom.json"), []byte(`{"base": "light"}`), 0666); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load("custom")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Name != "custom" || got.Color(Favorite) != lipgloss.Color("172") {
+		t.Errorf("got: %s, want: custom based on light", got.Name)
+	}
+}
@@ -0,0 +1,121 @@
+// Package timeline merges the activities of a user into a chronological list of events.
+package timeline
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+type Kind int
+
+const (
+	PullRequestOpened Kind = iota
+	PullRequestMerged
+	PullRequestClosed
+	RepositoryCreated
+	RepositoryPushed
+)
+
+func (k Kind) IsPullRequest() bool {
+	return k == PullRequestOpened || k == PullRequestMerged || k == PullRequestClosed
+}
+
+type Event struct {
+	Kind Kind
+	Time time.Time
+
+	Owner      string // only for pull requests
+	Repository string
+	Number     int // only for pull requests
+	Title      string
+	Url        string
+}
+
+func PullRequestEvents(prs *gh.UserPullRequests) []*Event {
+	events := make([]*Event, 0)
+	for _, owner := range prs.Owners {
+		for _, repo := range owner.Repositories {
+			for _, pr := range repo.PullRequests {
+				event := func(kind Kind, t time.Time) *Event {
+					return &Event{
+						Kind:       kind,
+						Time:       t,
+						Owner:      owner.Name,
+						Repository: repo.Name,
+						Number:     pr.Number,
+						Title:      pr.Title,
+						Url:        pr.Url,
+					}
+				}
+				events = append(events, event(PullRequestOpened, pr.CretaedAt))
+				switch pr.State {
+				case "MERGED":
+					// a merged pull request is closed at the time it is merged
+					events = append(events, event(PullRequestMerged, pr.ClosedAt))
+				case "CLOSED":
+					events = append(events, event(PullRequestClosed, pr.ClosedAt))
+				}
+			}
+		}
+	}
+	return events
+}
+
+func RepositoryEvents(repos *gh.UserRepositories) []*Event {
+	events := make([]*Event, 0)
+	for _, repo := range repos.Repositories {
+		event := func(kind Kind, t time.Time) *Event {
+			return &Event{
+				Kind:       kind,
+				Time:       t,
+				Repository: repo.Name,
+				Title:      repo.Description,
+				Url:        repo.Url,
+			}
+		}
+		events = append(events, event(RepositoryCreated, repo.CreatedAt))
+		// only the last push is known
+		if !repo.PushedAt.IsZero() && !repo.PushedAt.Equal(repo.CreatedAt) {
+			events = append(events, event(RepositoryPushed, repo.PushedAt))
+		}
+	}
+	return events
+}
+
+// Merge returns all the events, the newest first. Events without time are dropped.
+func Merge(events ...[]*Event) []*Event {
+	ret := make([]*Event, 0)
+	for _, es := range events {
+		for _, e := range es {
+			if !e.Time.IsZero() {
+				ret = append(ret, e)
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Time.After(ret[j].Time)
+	})
+	return ret
+}
+
+type Month struct {
+	Month  time.Time // the first day of the month
+	Events []*Event
+}
+
+// GroupByMonth groups the sorted events by month, keeping the order.
+func GroupByMonth(events []*Event) []*Month {
+	months := make([]*Month, 0)
+	for _, e := range events {
+		t := e.Time.Local()
+		month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+		if n := len(months); n == 0 || !months[n-1].Month.Equal(month) {
+			months = append(months, &Month{Month: month})
+		}
+		last := months[len(months)-1]
+		last.Events = append(last.Events, e)
+	}
+	return months
+}
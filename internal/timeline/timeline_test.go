@@ -0,0 +1,68 @@
+package timeline
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
+
+func TestTimeline(t *testing.T) {
+	prs := &gh.UserPullRequests{
+		Owners: []*gh.UserPullRequestsOwner{
+			{
+				Name: "foo",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{
+						Name: "a",
+						PullRequests: []*gh.UserPullRequestsPullRequest{
+							{Number: 1, State: "MERGED", CretaedAt: date(2022, 5, 1), ClosedAt: date(2022, 6, 3)},
+							{Number: 2, State: "OPEN", CretaedAt: date(2022, 5, 10)},
+						},
+					},
+				},
+			},
+		},
+	}
+	repos := &gh.UserRepositories{
+		Repositories: []*gh.UserRepository{
+			{Name: "b", CreatedAt: date(2022, 5, 5), PushedAt: date(2022, 7, 1)},
+			{Name: "c", CreatedAt: date(2022, 6, 1), PushedAt: date(2022, 6, 1)},
+		},
+	}
+	events := Merge(PullRequestEvents(prs), RepositoryEvents(repos))
+
+	type e struct {
+		kind Kind
+		name string
+	}
+	got := make([][]e, 0)
+	for _, month := range GroupByMonth(events) {
+		es := make([]e, 0)
+		for _, event := range month.Events {
+			es = append(es, e{event.Kind, event.Repository})
+		}
+		got = append(got, es)
+	}
+	want := [][]e{
+		{{RepositoryPushed, "b"}},
+		{{PullRequestMerged, "a"}, {RepositoryCreated, "c"}},
+		{{PullRequestOpened, "a"}, {RepositoryCreated, "b"}, {PullRequestOpened, "a"}},
+	}
+	if notEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
@@ -27,6 +27,12 @@ type aboutKeyMap struct {
 	Quit key.Binding
 }
 
+var aboutKeys = registerKeyScope("about",
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
 func (k aboutKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.Open,
@@ -58,18 +64,9 @@ type aboutModel struct {
 
 func newAboutModel() aboutModel {
 	keys := aboutKeyMap{
-		Open: key.NewBinding(
-			key.WithKeys("x"),
-			key.WithHelp("x", "open in browser"),
-		),
-		Back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		Quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		Open: aboutKeys.binding("open"),
+		Back: aboutKeys.binding("back"),
+		Quit: aboutKeys.binding("quit"),
 	}
 	return aboutModel{
 		keys: keys,
@@ -77,6 +74,12 @@ func newAboutModel() aboutModel {
 	}
 }
 
+func init() {
+	registerPage(func(*pageContext, selectAboutPageMsg) page {
+		return newRoutedPage(newAboutModel())
+	})
+}
+
 func (m *aboutModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
@@ -103,14 +106,33 @@ func (m aboutModel) Update(msg tea.Msg) (aboutModel, tea.Cmd) {
 		case key.Matches(msg, m.keys.Open):
 			return m, m.openThisRepositoryPageInBrowser()
 		case key.Matches(msg, m.keys.Back):
-			return m, goBackHelpPage
+			return m, goBack
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
 		}
+	case tea.MouseMsg:
+		if m.urlClicked(msg) {
+			return m, m.openThisRepositoryPageInBrowser()
+		}
 	}
 	return m, nil
 }
 
+// urlClicked reports whether the url of the application is clicked.
+func (m aboutModel) urlClicked(msg tea.MouseMsg) bool {
+	t, _, _, l := aboutItemUrlStyle.GetPadding()
+	above := titleView(m.breadcrumb()) + aboutItemAppNameStyle.Render(ghcv.AppName) + aboutItemStyle.Render("Version "+ghcv.Version)
+	return clickedRow(msg, cn(above)+t) == 0 && l <= msg.X && msg.X < l+lipgloss.Width(ghcv.AppUrl)
+}
+
+func (m aboutModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m aboutModel) yankTargets() (*yankTarget, []*yankTarget) {
+	return repositoryYankTarget(strings.TrimPrefix(ghcv.AppUrl, ghcv.GitHubBaseUrl), ghcv.AppUrl), nil
+}
+
 func (m aboutModel) View() string {
 	if m.height <= 0 {
 		return ""
@@ -0,0 +1,45 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+)
+
+func TestAboutUrlClicked(t *testing.T) {
+	m := newAboutModel()
+	m.SetSize(80, 24)
+
+	// the position of the url in the view
+	x, y := -1, -1
+	for i, line := range strings.Split(m.View(), "\n") {
+		if j := strings.Index(line, ghcv.AppUrl); j >= 0 {
+			x, y = j, i
+			break
+		}
+	}
+	if y < 0 {
+		t.Fatalf("the url is not shown")
+	}
+
+	tests := []struct {
+		name string
+		msg  tea.MouseMsg
+		want bool
+	}{
+		{name: "start", msg: testClick(x, y), want: true},
+		{name: "end", msg: testClick(x+len(ghcv.AppUrl)-1, y), want: true},
+		{name: "before", msg: testClick(x-1, y), want: false},
+		{name: "after", msg: testClick(x+len(ghcv.AppUrl), y), want: false},
+		{name: "above", msg: testClick(x, y-1), want: false},
+		{name: "below", msg: testClick(x, y+1), want: false},
+		{name: "wheel", msg: tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress}, want: false},
+	}
+	for _, test := range tests {
+		if got := m.urlClicked(test.msg); notEqual(got, test.want) {
+			t.Errorf("name: %s, got: %v, want: %v", test.name, got, test.want)
+		}
+	}
+}
@@ -1,86 +1,132 @@
 package ui
 
 import (
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/clipboard"
 	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 var (
 	baseStyle = lipgloss.NewStyle().Margin(1, 2)
 )
 
-type page int
-
-const (
-	authPage page = iota
-	userSelectPage
-	menuPage
-	profilePage
-	pullRequrstsPage
-	repositoriesPage
-	helpPage
-	aboutPage
-	creditsPage
-)
-
-type model struct {
-	client      *gh.GitHubClient
-	currentPage page
+type appKeyMap struct {
+	JumpBack    key.Binding
+	JumpForward key.Binding
+	Palette     key.Binding
+	Yank        key.Binding
+	YankAs      key.Binding
+	Reload      key.Binding
+	Sections    []key.Binding
+}
 
-	userSelect   userSelectModel
-	menu         menuModel
-	profile      profileModel
-	pullRequests pullRequestsModel
-	repositories repositoriesModel
-	help         helpModel
-	about        aboutModel
-	credits      creditsModel
+var appKeys = registerKeyScope("app",
+	newKeyAction("jump-back", "ctrl+o", "jump back", "ctrl+o"),
+	// ctrl+i, the pair of ctrl+o in vim, cannot be used since terminals send it as tab
+	newKeyAction("jump-forward", "ctrl+l", "jump forward", "ctrl+l"),
+	newKeyAction("palette", "ctrl+p", "command palette", "ctrl+p"),
+	newKeyAction("yank", "y", "copy url", "y"),
+	newKeyAction("yank-as", "Y", "copy as", "Y"),
+	newKeyAction("reload", "ctrl+r", "reload section", "ctrl+r"),
+).add(sectionKeyActions()...)
 
-	spinner *spinner.Model
+type model struct {
+	client *gh.GitHubClient
+	router router
+	keys   appKeyMap
+
+	currentUser string
+	// the pages of the sections by user
+	sections map[string]map[*userSection]page
+	// the selected users which are organizations
+	organizations map[string]bool
+
+	spinner    *spinner.Model
+	palette    *commandPalette
+	yankDialog *yankDialog
+
+	// the output of the program, to which OSC 52 is sent
+	output *os.File
+
+	// shown in place of the help for a while, such as the result of a copy
+	flash    string
+	flashSeq int
+	// shown when the application is started
+	warnings []string
 }
 
 func newModel(client *gh.GitHubClient) model {
 	s := spinner.New()
 	s.Spinner = spinner.Moon
-	return model{
-		client:       client,
-		currentPage:  userSelectPage,
-		userSelect:   newUserSelectModel(client, &s),
-		menu:         newMenuModel(),
-		profile:      newProfileModel(client, &s),
-		pullRequests: newPullRequestsModel(client, &s),
-		repositories: newRepositoriesModel(client, &s),
-		help:         newHelpModel(),
-		about:        newAboutModel(),
-		credits:      newCreditsModel(),
-		spinner:      &s,
+	keys := appKeyMap{
+		JumpBack:    appKeys.binding("jump-back"),
+		JumpForward: appKeys.binding("jump-forward"),
+		Palette:     appKeys.binding("palette"),
+		Yank:        appKeys.binding("yank"),
+		YankAs:      appKeys.binding("yank-as"),
+		Reload:      appKeys.binding("reload"),
+		Sections:    sectionKeyBindings(),
 	}
+	m := model{
+		client:        client,
+		keys:          keys,
+		sections:      make(map[string]map[*userSection]page),
+		organizations: make(map[string]bool),
+		spinner:       &s,
+		palette:       newCommandPalette(keys.Palette.Keys()),
+		yankDialog:    newYankDialog(keys.YankAs.Keys()),
+		output:        os.Stdout,
+	}
+	m.router.push(&route{page: newRoutedPage(newUserSelectModel(client, &s))})
+	return m
 }
 
 func (m model) Init() tea.Cmd {
-	return m.spinner.Tick
+	cmds := []tea.Cmd{m.spinner.Tick}
+	for _, w := range m.warnings {
+		cmds = append(cmds, warn(w))
+	}
+	return tea.Batch(cmds...)
 }
 
 type userSelectMsg struct {
-	id string
+	id           string
+	organization bool // the organization has only the sections of an owner
 }
 
 var _ tea.Msg = (*userSelectMsg)(nil)
 
 func userSelected(id string) tea.Cmd {
-	return func() tea.Msg { return userSelectMsg{id} }
+	return func() tea.Msg { return userSelectMsg{id, false} }
+}
+
+// ownerSelected selects the owner of repositories, which can be an organization.
+func ownerSelected(id string, organization bool) tea.Cmd {
+	return func() tea.Msg { return userSelectMsg{id, organization} }
 }
 
 type selectRepositoriesPageMsg struct {
-	id string
+	id    string
+	focus string // the name of the repository to select after loading, if not empty
 }
 
 var _ tea.Msg = (*selectRepositoriesPageMsg)(nil)
 
 func selectRepositoriesPage(id string) tea.Cmd {
-	return func() tea.Msg { return selectRepositoriesPageMsg{id} }
+	return func() tea.Msg { return selectRepositoriesPageMsg{id, ""} }
+}
+
+func selectRepositoriesPageFocused(id, repo string) tea.Cmd {
+	return func() tea.Msg { return selectRepositoriesPageMsg{id, repo} }
 }
 
 type selectProfilePageMsg struct {
@@ -93,6 +139,27 @@ func selectProfilePage(id string) tea.Cmd {
 	return func() tea.Msg { return selectProfilePageMsg{id} }
 }
 
+type selectComparePageMsg struct {
+	id    string
+	other string
+}
+
+var _ tea.Msg = (*selectComparePageMsg)(nil)
+
+func selectComparePage(id, other string) tea.Cmd {
+	return func() tea.Msg { return selectComparePageMsg{id, other} }
+}
+
+type selectTeamPageMsg struct {
+	team *team
+}
+
+var _ tea.Msg = (*selectTeamPageMsg)(nil)
+
+func selectTeamPage(t *team) tea.Cmd {
+	return func() tea.Msg { return selectTeamPageMsg{t} }
+}
+
 type goBackUserSelectPageMsg struct{}
 
 var _ tea.Msg = (*goBackUserSelectPageMsg)(nil)
@@ -102,13 +169,59 @@ func goBackUserSelectPage() tea.Msg {
 }
 
 type selectPullRequestsPageMsg struct {
-	id string
+	id    string
+	focus *pullRequestsFocus // the pull request to select after loading, if not nil
 }
 
 var _ tea.Msg = (*selectPullRequestsPageMsg)(nil)
 
 func selectPullRequestsPage(id string) tea.Cmd {
-	return func() tea.Msg { return selectPullRequestsPageMsg{id} }
+	return func() tea.Msg { return selectPullRequestsPageMsg{id, nil} }
+}
+
+func selectPullRequestsPageFocused(id string, focus *pullRequestsFocus) tea.Cmd {
+	return func() tea.Msg { return selectPullRequestsPageMsg{id, focus} }
+}
+
+type selectPullRequestStatsPageMsg struct {
+	id string
+}
+
+var _ tea.Msg = (*selectPullRequestStatsPageMsg)(nil)
+
+func selectPullRequestStatsPage(id string) tea.Cmd {
+	return func() tea.Msg { return selectPullRequestStatsPageMsg{id} }
+}
+
+type selectRepositoryStatsPageMsg struct {
+	id string
+}
+
+var _ tea.Msg = (*selectRepositoryStatsPageMsg)(nil)
+
+func selectRepositoryStatsPage(id string) tea.Cmd {
+	return func() tea.Msg { return selectRepositoryStatsPageMsg{id} }
+}
+
+type selectTimelinePageMsg struct {
+	id string
+}
+
+var _ tea.Msg = (*selectTimelinePageMsg)(nil)
+
+func selectTimelinePage(id string) tea.Cmd {
+	return func() tea.Msg { return selectTimelinePageMsg{id} }
+}
+
+type selectFollowsPageMsg struct {
+	id   string
+	kind followsKind
+}
+
+var _ tea.Msg = (*selectFollowsPageMsg)(nil)
+
+func selectFollowsPage(id string, kind followsKind) tea.Cmd {
+	return func() tea.Msg { return selectFollowsPageMsg{id, kind} }
 }
 
 type selectHelpPageMsg struct{}
@@ -135,136 +248,367 @@ func selectCreditsPage() tea.Msg {
 	return selectCreditsPageMsg{}
 }
 
-type goBackMenuPageMsg struct{}
+type selectKeybindingsPageMsg struct{}
+
+var _ tea.Msg = (*selectKeybindingsPageMsg)(nil)
+
+func selectKeybindingsPage() tea.Msg {
+	return selectKeybindingsPageMsg{}
+}
+
+func (m model) pageContext() *pageContext {
+	return &pageContext{
+		client:        m.client,
+		spinner:       m.spinner,
+		user:          m.currentUser,
+		previousUsers: m.router.users(),
+	}
+}
+
+// userSections returns the sections of the current user, without the ones of a user if it is an organization.
+func (m model) userSections() []*userSection {
+	return userSectionsOf(m.organizations[m.currentUser])
+}
+
+func (m *model) storeSection(rt *route) {
+	if m.sections[rt.user] == nil {
+		m.sections[rt.user] = make(map[*userSection]page)
+	}
+	m.sections[rt.user][rt.section] = rt.page
+}
+
+// switchSection shows the page of the section of the current user in place of the current section.
+// The page is loaded only when the section is shown for the first time.
+func (m *model) switchSection(s *userSection) tea.Cmd {
+	rt := &route{user: m.currentUser, section: s}
+	var msg tea.Msg
+	if p, ok := m.sections[m.currentUser][s]; ok {
+		rt.page = p
+	} else {
+		msg = s.open(m.currentUser)()
+		rt.page = pageRoutes[s.msgType](m.pageContext(), msg)
+		m.storeSection(rt)
+	}
+	if i := m.router.section(); i >= 0 {
+		m.router.replace(i, rt)
+	} else {
+		m.router.push(rt)
+	}
+	if msg == nil {
+		return nil
+	}
+	return rt.page.Update(msg)
+}
+
+func (m model) capturingInput() bool {
+	c, ok := m.router.top().page.(inputCapturer)
+	return ok && c.capturingInput()
+}
+
+func (m model) canSwitchSection() bool {
+	return hasTabBar(m.router.top()) && !m.capturingInput()
+}
 
-var _ tea.Msg = (*goBackMenuPageMsg)(nil)
+func (m model) canOpenPalette() bool {
+	return !m.capturingInput()
+}
 
-func goBackMenuPage() tea.Msg {
-	return goBackMenuPageMsg{}
+// yankTargets returns the items of the current page to copy, or the user of the page if no item is selected.
+func (m model) yankTargets() (*yankTarget, []*yankTarget) {
+	top := m.router.top()
+	var selected *yankTarget
+	var visible []*yankTarget
+	if y, ok := top.page.(yanker); ok {
+		selected, visible = y.yankTargets()
+	}
+	if selected == nil && hasTabBar(top) {
+		selected = userYankTarget(top.user)
+	}
+	return selected, visible
 }
 
-type goBackHelpPageMsg struct{}
+// canYank reports whether the current page has something to copy.
+// Otherwise the keys are left to the page, so that they can be typed in the user input.
+func (m model) canYank() bool {
+	if m.capturingInput() {
+		return false
+	}
+	selected, visible := m.yankTargets()
+	return selected != nil || len(visible) > 0
+}
 
-var _ tea.Msg = (*goBackHelpPageMsg)(nil)
+// canReload reports whether the current page is of a section of the user, or opened from it.
+func (m model) canReload() bool {
+	return m.canSwitchSection() && m.router.section() >= 0
+}
 
-func goBackHelpPage() tea.Msg {
-	return goBackHelpPageMsg{}
+// reloadSection loads the section of the current user again, in place of the pages opened from it.
+func (m *model) reloadSection() tea.Cmd {
+	s := m.router.stack[m.router.section()].section
+	delete(m.sections[m.currentUser], s)
+	return m.switchSection(s)
 }
 
-func (m *model) SetSize(width, height int) {
-	m.userSelect.SetSize(width, height)
-	m.menu.SetSize(width, height)
-	m.profile.SetSize(width, height)
-	m.pullRequests.SetSize(width, height)
-	m.repositories.SetSize(width, height)
-	m.help.SetSize(width, height)
-	m.about.SetSize(width, height)
-	m.credits.SetSize(width, height)
+// clickBreadcrumb returns to the page of the clicked breadcrumb, or to the user input by the title of the application.
+func (m *model) clickBreadcrumb(x int) tea.Cmd {
+	if m.capturingInput() {
+		return nil
+	}
+	b, ok := m.router.top().page.(breadcrumber)
+	if !ok {
+		return nil
+	}
+	bcs := b.breadcrumb()
+	i, ok := breadcrumbAt(bcs, x)
+	switch {
+	case !ok || i == len(bcs)-1:
+		return nil
+	case i < 0:
+		if m.router.isRoot() {
+			return nil
+		}
+		return goBackUserSelectPage
+	}
+	if m.router.popToBreadcrumb(bcs[i]) {
+		m.currentUser = m.router.top().user
+	}
+	return nil
 }
 
-func (m *model) SetUser(id string) {
-	m.menu.SetUser(id)
-	m.profile.SetUser(id)
-	m.repositories.SetUser(id)
-	m.pullRequests.SetUser(id)
+// paletteCommands lists the actions available on the current page, the keys of the page and then of the application.
+func (m model) paletteCommands() []paletteCommand {
+	commands := make([]paletteCommand, 0)
+	seen := make(map[string]bool)
+	add := func(b key.Binding) {
+		if c, ok := bindingCommand(b); ok && !seen[c.name+c.key] {
+			seen[c.name+c.key] = true
+			commands = append(commands, c)
+		}
+	}
+	if k, ok := m.router.top().page.(keyBinder); ok {
+		for _, bindings := range k.keyBindings() {
+			for _, b := range bindings {
+				add(b)
+			}
+		}
+	}
+	if hasTabBar(m.router.top()) {
+		commands = append(commands, sectionCommands(m.userSections(), m.keys.Sections)...)
+	}
+	if !m.router.isRoot() {
+		commands = append(commands, paletteCommand{name: "switch user", msg: goBackUserSelectPageMsg{}})
+	}
+	if m.canYank() {
+		add(m.keys.Yank)
+		add(m.keys.YankAs)
+	}
+	if m.canReload() {
+		add(m.keys.Reload)
+	}
+	add(m.keys.JumpBack)
+	add(m.keys.JumpForward)
+	return commands
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	m, cmd := m.update(msg)
+	// the settings changed by the page, such as the sort order, are stored at once
+	if err := storePageSettings(); err != nil {
+		return m, tea.Batch(cmd, m.showFlash(flashErrorStyle.Render("Failed to save the settings: "+err.Error())))
+	}
+	return m, cmd
+}
+
+func (m model) update(msg tea.Msg) (model, tea.Cmd) {
 	var cmd tea.Cmd
-	cmds := make([]tea.Cmd, 0)
+
+	if m.palette.opened {
+		// the palette takes the keys and the mouse while it is opened
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			return m, m.palette.update(msg)
+		case tea.MouseMsg:
+			return m, m.palette.update(relativeMouseMsg(msg))
+		}
+	}
+	if m.yankDialog.opened {
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			return m, m.yankDialog.update(msg)
+		case tea.MouseMsg:
+			return m, m.yankDialog.update(relativeMouseMsg(msg))
+		}
+	}
+
+	if mouseMsg, ok := msg.(tea.MouseMsg); ok {
+		mouseMsg = relativeMouseMsg(mouseMsg)
+		if mouseMsg.Y == 0 {
+			if clickedRow(mouseMsg, 0) == 0 {
+				cmd := m.clickBreadcrumb(mouseMsg.X)
+				return m, cmd
+			}
+			return m, nil
+		}
+		if hasTabBar(m.router.top()) {
+			if mouseMsg.Y == 1 {
+				if s := tabAt(mouseMsg.X, m.userSections(), m.keys.Sections); s != nil && clickedRow(mouseMsg, 1) == 0 && m.canSwitchSection() {
+					return m, m.switchSection(s)
+				}
+				return m, nil
+			}
+			if mouseMsg.Y > 1 {
+				mouseMsg.Y -= tabBarHeight
+			}
+		}
+		msg = mouseMsg
+	}
 
 	switch msg := msg.(type) {
+	case pageMsg:
+		if msg.to != m.router.top().page {
+			// the page is not shown, so the message is only for the page
+			return m, msg.to.Update(msg.msg)
+		}
+		return m.update(msg.msg)
 	case tea.KeyMsg:
-		switch keypress := msg.String(); keypress {
-		case "ctrl+c":
+		if s := userSectionOfKey(msg, m.userSections(), m.keys.Sections); s != nil && m.canSwitchSection() {
+			return m, m.switchSection(s)
+		}
+		switch {
+		case msg.String() == "ctrl+c":
 			return m, tea.Quit
+		case key.Matches(msg, m.keys.JumpBack):
+			if m.router.jumpBack() {
+				m.currentUser = m.router.top().user
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.JumpForward):
+			if m.router.jumpForward() {
+				m.currentUser = m.router.top().user
+			}
+			return m, nil
+		case key.Matches(msg, m.keys.Palette) && m.canOpenPalette():
+			return m, m.palette.open(m.paletteCommands())
+		case key.Matches(msg, m.keys.Yank) && m.canYank():
+			selected, visible := m.yankTargets()
+			if selected == nil {
+				// nothing is selected, so only the visible items can be copied
+				m.yankDialog.open(nil, visible)
+				return m, nil
+			}
+			return m, yank("the URL", selected.url)
+		case key.Matches(msg, m.keys.YankAs) && m.canYank():
+			m.yankDialog.open(m.yankTargets())
+			return m, nil
+		case key.Matches(msg, m.keys.Reload) && m.canReload():
+			return m, m.reloadSection()
 		}
+	case yankedMsg:
+		if msg.method == clipboard.OSC52 {
+			// written in the event loop at once, not to be interleaved with the other sequences to the terminal
+			msg.err = clipboard.WriteOSC52(m.output, msg.text)
+		}
+		return m, m.showFlash(msg.flash())
+	case warningMsg:
+		return m, m.showFlashFor(flashErrorStyle.Render(msg.warning), flashWarningDuration)
+	case flashExpiredMsg:
+		if msg.seq == m.flashSeq {
+			m.flash = ""
+		}
+		return m, nil
 	case spinner.TickMsg:
 		*m.spinner, cmd = m.spinner.Update(msg)
 		return m, cmd
 	case tea.WindowSizeMsg:
 		top, right, bottom, left := baseStyle.GetMargin()
-		m.SetSize(msg.Width-left-right, msg.Height-top-bottom)
+		m.router.SetSize(msg.Width-left-right, msg.Height-top-bottom)
+		m.palette.SetSize(m.router.width, m.router.height)
+		m.yankDialog.SetSize(m.router.width, m.router.height)
 	case userSelectMsg:
-		m.SetUser(msg.id)
-		m.currentPage = menuPage
-	case selectProfilePageMsg:
-		m.currentPage = profilePage
-	case selectPullRequestsPageMsg:
-		m.currentPage = pullRequrstsPage
-	case selectRepositoriesPageMsg:
-		m.currentPage = repositoriesPage
-	case selectHelpPageMsg:
-		m.currentPage = helpPage
-	case selectAboutPageMsg:
-		m.currentPage = aboutPage
-	case selectCreditsPageMsg:
-		m.currentPage = creditsPage
+		m.currentUser = msg.id
+		if msg.organization {
+			m.organizations[msg.id] = true
+		}
+	case goBackMsg:
+		if !m.router.pop() {
+			return m, nil
+		}
+		m.currentUser = m.router.top().user
+		if !m.router.isRoot() {
+			return m, nil
+		}
+		// the user select page is reset when it is shown again
+		return m, m.router.top().page.Update(goBackUserSelectPageMsg{})
 	case goBackUserSelectPageMsg:
-		m.currentPage = userSelectPage
-	case goBackMenuPageMsg:
-		m.currentPage = menuPage
-	case goBackHelpPageMsg:
-		m.currentPage = helpPage
-	}
-
-	switch m.currentPage {
-	case userSelectPage:
-		m.userSelect, cmd = m.userSelect.Update(msg)
-		cmds = append(cmds, cmd)
-	case menuPage:
-		m.menu, cmd = m.menu.Update(msg)
-		cmds = append(cmds, cmd)
-	case profilePage:
-		m.profile, cmd = m.profile.Update(msg)
-		cmds = append(cmds, cmd)
-	case pullRequrstsPage:
-		m.pullRequests, cmd = m.pullRequests.Update(msg)
-		cmds = append(cmds, cmd)
-	case repositoriesPage:
-		m.repositories, cmd = m.repositories.Update(msg)
-		cmds = append(cmds, cmd)
-	case helpPage:
-		m.help, cmd = m.help.Update(msg)
-		cmds = append(cmds, cmd)
-	case aboutPage:
-		m.about, cmd = m.about.Update(msg)
-		cmds = append(cmds, cmd)
-	case creditsPage:
-		m.credits, cmd = m.credits.Update(msg)
-		cmds = append(cmds, cmd)
-	default:
-		return m, nil
+		m.router.popToRoot()
+		m.currentUser = ""
 	}
 
-	return m, tea.Batch(cmds...)
+	if newPage, ok := pageRoutes[reflect.TypeOf(msg)]; ok {
+		rt := &route{
+			page:    newPage(m.pageContext(), msg),
+			user:    m.currentUser,
+			section: findUserSection(msg),
+		}
+		m.router.push(rt)
+		if rt.section != nil {
+			m.storeSection(rt)
+		}
+	}
+	return m, m.router.top().page.Update(msg)
+}
+
+func (m *model) showFlash(flash string) tea.Cmd {
+	return m.showFlashFor(flash, flashDuration)
+}
+
+func (m *model) showFlashFor(flash string, d time.Duration) tea.Cmd {
+	m.flashSeq++
+	m.flash = flash
+	return flashExpired(m.flashSeq, d)
+}
+
+// relativeMouseMsg translates the position of the mouse event to be relative to the page.
+func relativeMouseMsg(msg tea.MouseMsg) tea.MouseMsg {
+	top, _, _, left := baseStyle.GetMargin()
+	msg.X -= left
+	msg.Y -= top
+	return msg
 }
 
 func (m model) View() string {
-	switch m.currentPage {
-	case userSelectPage:
-		return baseStyle.Render(m.userSelect.View())
-	case menuPage:
-		return baseStyle.Render(m.menu.View())
-	case profilePage:
-		return baseStyle.Render(m.profile.View())
-	case pullRequrstsPage:
-		return baseStyle.Render(m.pullRequests.View())
-	case repositoriesPage:
-		return baseStyle.Render(m.repositories.View())
-	case helpPage:
-		return baseStyle.Render(m.help.View())
-	case aboutPage:
-		return baseStyle.Render(m.about.View())
-	case creditsPage:
-		return baseStyle.Render(m.credits.View())
-	}
-	return baseStyle.Render("error... :(")
+	top := m.router.top()
+	view := top.page.View()
+	if hasTabBar(top) {
+		var active *userSection
+		if i := m.router.section(); i >= 0 {
+			active = m.router.stack[i].section
+		}
+		view = withTabBar(view, tabBarView(active, m.userSections(), m.keys.Sections, m.router.width))
+	}
+	if m.flash != "" {
+		view = withFlash(view, m.flash)
+	}
+	if m.yankDialog.opened {
+		view = m.yankDialog.view(view)
+	}
+	if m.palette.opened {
+		view = m.palette.view(view)
+	}
+	return baseStyle.Render(view)
 }
 
 func Start(client *gh.GitHubClient) error {
+	// the key maps of the pages are built with the remapped keys
+	if err := loadKeymap(); err != nil {
+		return fmt.Errorf("failed to load keymap: %w", err)
+	}
 	m := newModel(client)
-	p := tea.NewProgram(m, tea.WithAltScreen())
+	// the styles are built with the default theme if the chosen one cannot be loaded
+	if err := theme.Err(); err != nil {
+		m.warnings = append(m.warnings, "Failed to load theme, the default is used: "+err.Error())
+	}
+	p := tea.NewProgram(m, tea.WithOutput(m.output), tea.WithAltScreen(), tea.WithMouseCellMotion())
 	_, err := p.Run()
 	return err
 }
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func TestSwitchSectionWhileLoading(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	repositories := findUserSection(selectRepositoriesPageMsg{})
+	profile := findUserSection(selectProfilePageMsg{})
+	repos := &gh.UserRepositories{
+		TotalCount:   1,
+		Repositories: []*gh.UserRepository{{Name: "foo"}},
+	}
+
+	m := newModel(nil)
+	m.router.SetSize(80, 24)
+	m.router.push(&route{page: newRoutedPage(newMenuModel(false)), user: "alice"})
+	m.currentUser = "alice"
+
+	// the commands loading the pages are not run, and their results are sent below
+	m.switchSection(repositories)
+	loading := m.router.top().page
+	m.switchSection(profile)
+
+	var tm tea.Model
+	tm, _ = m.Update(pageMsg{loading, repositoriesSuccessMsg{repos}})
+	m = tm.(model)
+	if got := m.router.top().section; notEqual(got, profile) {
+		t.Errorf("section got: %s, want: %s", got.title, profile.title)
+	}
+
+	m.switchSection(repositories)
+	if got := m.router.top().page; got != loading {
+		t.Fatalf("the page of the section is not restored")
+	}
+	rp := loading.(*routedPage[repositoriesModel, *repositoriesModel])
+	if got := rp.model.loading; notEqual(got, false) {
+		t.Errorf("loading got: %v, want: %v", got, false)
+	}
+	if got := len(rp.model.list.Items()); notEqual(got, 1) {
+		t.Errorf("items got: %d, want: %d", got, 1)
+	}
+}
+
+func TestClickBreadcrumb(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	bcs := []string{"alice", "Repositories", "foo"}
+	title := titleView(bcs)
+	tests := []struct {
+		name string
+		x    int
+		want string // the page on the top
+		back bool   // whether it returns to the user input
+	}{
+		{name: "user", x: strings.Index(title, "alice"), want: "alice"},
+		{name: "page", x: strings.Index(title, "Repositories"), want: "Repositories"},
+		{name: "current page", x: strings.Index(title, "foo"), want: "foo"},
+		{name: "separator", x: strings.Index(title, ">"), want: "foo"},
+		{name: "title", x: strings.Index(title, appTitle), want: "foo", back: true},
+	}
+	for _, test := range tests {
+		m := newModel(nil)
+		m.router.SetSize(80, 24)
+		for i := range bcs {
+			p := newRoutedPage(testPageModel{name: bcs[i], bcs: bcs[:i+1]})
+			m.router.push(&route{page: p})
+		}
+
+		top, _, _, left := baseStyle.GetMargin()
+		tm, cmd := m.Update(tea.MouseMsg{X: left + test.x, Y: top, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress})
+		m = tm.(model)
+		if got := m.router.top().page.View(); notEqual(got, test.want) {
+			t.Errorf("name: %s, top got: %s, want: %s", test.name, got, test.want)
+		}
+		back := false
+		if cmd != nil {
+			_, back = cmd().(goBackUserSelectPageMsg)
+		}
+		if notEqual(back, test.back) {
+			t.Errorf("name: %s, back got: %v, want: %v", test.name, back, test.back)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/stats"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+var (
+	chartBarStyle = lipgloss.NewStyle().
+			Foreground(theme.SelectedSub.Color())
+
+	chartLabelStyle = lipgloss.NewStyle().
+			Foreground(theme.Dimmed.Color())
+)
+
+// barChartView draws a horizontal bar for each count, scaled to fit in width.
+func barChartView(counts []stats.Count, width int) string {
+	labelWidth, valueWidth, maxCount := 0, 0, 0
+	for _, c := range counts {
+		labelWidth = max(labelWidth, lipgloss.Width(c.Name))
+		valueWidth = max(valueWidth, len(fmt.Sprint(c.Count)))
+		maxCount = max(maxCount, c.Count)
+	}
+	barWidth := max(width-labelWidth-valueWidth-2, 1)
+
+	lines := make([]string, len(counts))
+	for i, c := range counts {
+		n := 0
+		if maxCount > 0 {
+			n = c.Count * barWidth / maxCount
+		}
+		if n == 0 && c.Count > 0 {
+			n = 1
+		}
+		label := chartLabelStyle.Render(c.Name + strings.Repeat(" ", labelWidth-lipgloss.Width(c.Name)))
+		bar := chartBarStyle.Render(strings.Repeat("█", n))
+		lines[i] = fmt.Sprintf("%s %s %d", label, bar, c.Count)
+	}
+	return strings.Join(lines, "\n")
+}
+
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// sparklineView draws the values as a line of block characters, scaled to the largest value.
+func sparklineView(values []int) string {
+	maxValue := 0
+	for _, v := range values {
+		maxValue = max(maxValue, v)
+	}
+	runes := make([]rune, len(values))
+	for i, v := range values {
+		level := 0
+		if maxValue > 0 {
+			level = v * (len(sparklineLevels) - 1) / maxValue
+		}
+		runes[i] = sparklineLevels[level]
+	}
+	return chartBarStyle.Render(string(runes))
+}
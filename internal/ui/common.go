@@ -2,14 +2,19 @@ package ui
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 const (
 	appTitle = "GHCV"
+
+	breadcrumbSeparator = " > "
 )
 
 var (
@@ -17,17 +22,21 @@ var (
 			Padding(0, 0, 1, 2)
 
 	titleStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("97")).
-			Foreground(lipgloss.Color("229")).
+			Background(theme.TitleBackground.Color()).
+			Foreground(theme.TitleText.Color()).
 			Padding(0, 1)
 
 	breadcrumbStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240")).
+			Foreground(theme.Muted.Color()).
 			Padding(0, 0, 0, 2)
 
 	listStyle = lipgloss.NewStyle().
 			MarginTop(1)
 
+	// the list title is not shown, so this line is used only for the filter input
+	listTitleBarStyle = lipgloss.NewStyle().
+				Padding(0, 0, 0, 2)
+
 	spinnerStyle = lipgloss.NewStyle().
 			Padding(2, 0, 0, 2)
 
@@ -35,16 +44,13 @@ var (
 			Padding(1, 0, 0, 2)
 
 	urlTextStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("33")).
+			Foreground(theme.Link.Color()).
 			Underline(true)
 )
 
 var (
-	selectedColor1 = lipgloss.Color("142")
-	selectedColor2 = lipgloss.Color("143")
-
 	listNormalTitleColorStyle = lipgloss.NewStyle().
-					Foreground(lipgloss.AdaptiveColor{Light: "#1a1a1a", Dark: "#dddddd"})
+					Foreground(theme.Text.Color())
 
 	listNormalItemStyle = lipgloss.NewStyle().
 				Padding(0, 0, 0, 2)
@@ -53,42 +59,208 @@ var (
 				Padding(0, 0, 0, 2)
 
 	listNormalDescColorStyle = lipgloss.NewStyle().
-					Foreground(lipgloss.AdaptiveColor{Light: "#A49FA5", Dark: "#777777"})
+					Foreground(theme.SubText.Color())
 
 	listNormalDescStyle = listNormalDescColorStyle.Copy().
 				Padding(0, 0, 0, 2)
 
 	listSelectedTitleColorStyle = lipgloss.NewStyle().
-					Foreground(selectedColor1)
+					Foreground(theme.Selected.Color())
 
 	listSelectedItemStyle = lipgloss.NewStyle().
 				Border(lipgloss.NormalBorder(), false, false, false, true).
-				BorderForeground(selectedColor2).
+				BorderForeground(theme.SelectedSub.Color()).
 				Padding(0, 0, 0, 1)
 
 	listSelectedTitleStyle = listSelectedItemStyle.Copy().
-				Foreground(selectedColor1)
+				Foreground(theme.Selected.Color())
 
 	listSelectedDescColorStyle = listSelectedTitleColorStyle.Copy().
-					Foreground(selectedColor2)
+					Foreground(theme.SelectedSub.Color())
 
 	listSelectedDescStyle = listSelectedItemStyle.Copy().
-				Foreground(selectedColor2)
+				Foreground(theme.SelectedSub.Color())
+
+	listFilterMatchStyle = lipgloss.NewStyle().
+				Underline(true)
+
+	listFilterPromptStyle = lipgloss.NewStyle().
+				Foreground(theme.Selected.Color())
 )
 
 func titleView(bcs []string) string {
 	// bubbles/list/styles.go
 	title := titleStyle.Render(appTitle)
 	if bcs != nil {
-		title += breadcrumbStyle.Render(strings.Join(bcs, " > "))
+		title += breadcrumbStyle.Render(strings.Join(bcs, breadcrumbSeparator))
 	}
 	return titleBarStyle.Render(title)
 }
 
+// breadcrumbAt returns the index of the breadcrumb at the column x of titleView, or -1 for the title of the application.
+func breadcrumbAt(bcs []string, x int) (int, bool) {
+	x -= titleBarStyle.GetPaddingLeft()
+	w := lipgloss.Width(titleStyle.Render(appTitle))
+	if 0 <= x && x < w {
+		return -1, true
+	}
+	x -= w + breadcrumbStyle.GetPaddingLeft()
+	for i, bc := range bcs {
+		w := lipgloss.Width(bc)
+		if 0 <= x && x < w {
+			return i, true
+		}
+		x -= w + lipgloss.Width(breadcrumbSeparator)
+	}
+	return 0, false
+}
+
 func listView(l list.Model) string {
+	if l.FilterState() == list.FilterApplied {
+		l.SetShowStatusBar(true)
+	}
 	return listStyle.Render(l.View())
 }
 
+// wheelDelta returns the move of the cursor by the mouse wheel.
+func wheelDelta(msg tea.MouseMsg) int {
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		return 1
+	case tea.MouseButtonWheelUp:
+		return -1
+	}
+	return 0
+}
+
+// clickedRow returns the line of the left click from the line top, or -1 if not clicked below it.
+func clickedRow(msg tea.MouseMsg, top int) int {
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress || msg.Y < top {
+		return -1
+	}
+	return msg.Y - top
+}
+
+// listItemsOffset returns the number of lines above the first item in the view of the list.
+func listItemsOffset(l list.Model) int {
+	n := 0
+	if l.ShowTitle() || (l.ShowFilter() && l.FilteringEnabled()) {
+		n += lipgloss.Height(l.Styles.TitleBar.Render(""))
+	}
+	// listView shows the status bar while the filter is applied
+	if l.ShowStatusBar() || l.FilterState() == list.FilterApplied {
+		n += lipgloss.Height(l.Styles.StatusBar.Render(""))
+	}
+	return n
+}
+
+// listItemAt returns the index of the item at the line y of the items of the list, or -1.
+func listItemAt(l list.Model, d list.ItemDelegate, y int) int {
+	pitch := d.Height() + d.Spacing()
+	if y < 0 || pitch <= 0 || y%pitch >= d.Height() {
+		return -1
+	}
+	i := y / pitch
+	if i >= l.Paginator.ItemsOnPage(len(l.VisibleItems())) {
+		return -1
+	}
+	return l.Paginator.Page*l.Paginator.PerPage + i
+}
+
+// updateListMouse moves the cursor of the list by the wheel and selects the clicked item,
+// where top is the line of the first item. It reports whether the selected item is clicked again, to open it.
+func updateListMouse(l *list.Model, d list.ItemDelegate, msg tea.MouseMsg, top int) bool {
+	if l.FilterState() == list.Filtering {
+		return false
+	}
+	switch wheelDelta(msg) {
+	case 1:
+		l.CursorDown()
+		return false
+	case -1:
+		l.CursorUp()
+		return false
+	}
+	row := clickedRow(msg, top)
+	if row < 0 {
+		return false
+	}
+	i := listItemAt(*l, d, row)
+	if i < 0 {
+		return false
+	}
+	if i == l.Index() {
+		return true
+	}
+	l.Select(i)
+	return false
+}
+
+func setupListFiltering(l *list.Model) {
+	l.SetFilteringEnabled(true)
+	l.Styles.TitleBar = listTitleBarStyle
+	// the input copies the styles when the list is created
+	l.Styles.FilterPrompt = listFilterPromptStyle
+	l.Styles.FilterCursor = listFilterPromptStyle
+	l.FilterInput.PromptStyle = listFilterPromptStyle
+	l.FilterInput.Cursor.Style = listFilterPromptStyle
+}
+
+// newListItemStyles returns the styles of the default delegate in the colors of the theme.
+func newListItemStyles() list.DefaultItemStyles {
+	// bubbles/list/defaultitem.go
+	s := list.NewDefaultItemStyles()
+	s.NormalTitle = listNormalTitleStyle
+	s.NormalDesc = listNormalDescStyle
+	s.SelectedTitle = listSelectedTitleStyle
+	s.SelectedDesc = listSelectedDescStyle
+	s.DimmedTitle = listNormalDescStyle
+	s.DimmedDesc = listNormalDescStyle.Copy().Foreground(theme.Muted.Color())
+	s.FilterMatch = listFilterMatchStyle
+	return s
+}
+
+// filterValue holds the searchable fields of a list item,
+// so that the matched characters can be mapped back to each field.
+type filterValue []string
+
+func (v filterValue) String() string {
+	return strings.Join(v, " ")
+}
+
+// matchesOf returns the rune indexes of the matched characters in the i-th field.
+// matches are the byte indexes in the joined string.
+func (v filterValue) matchesOf(i int, matches []int) []int {
+	start := 0
+	for j := 0; j < i; j++ {
+		start += len(v[j]) + 1
+	}
+	end := start + len(v[i])
+	ret := make([]int, 0)
+	for _, m := range matches {
+		if start <= m && m < end {
+			ret = append(ret, utf8.RuneCountInString(v[i][:m-start]))
+		}
+	}
+	return ret
+}
+
+func listItemMatches(m list.Model, index int) []int {
+	if m.FilterState() == list.Unfiltered || index >= len(m.VisibleItems()) {
+		return nil
+	}
+	return m.MatchesForItem(index)
+}
+
+func highlightMatches(s string, matches []int, base lipgloss.Style) string {
+	if len(matches) == 0 {
+		return base.Render(s)
+	}
+	unmatched := base.Copy().Inline(true)
+	matched := unmatched.Copy().Inherit(listFilterMatchStyle)
+	return lipgloss.StyleRunes(s, matches, matched, unmatched)
+}
+
 func loadingView(s *spinner.Model, bc []string) string {
 	ret := ""
 
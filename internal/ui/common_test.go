@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestBreadcrumbAt(t *testing.T) {
+	bcs := []string{"alice", "Repositories", "foo"}
+	title := titleView(bcs)
+	tests := []struct {
+		x     int
+		want  int
+		found bool
+	}{
+		{x: strings.Index(title, appTitle), want: -1, found: true},
+		{x: strings.Index(title, appTitle) + len(appTitle) - 1, want: -1, found: true},
+		{x: strings.Index(title, "alice"), want: 0, found: true},
+		{x: strings.Index(title, "alice") + len("alice") - 1, want: 0, found: true},
+		{x: strings.Index(title, "alice") + len("alice"), want: 0, found: false},
+		{x: strings.Index(title, "Repositories") + 3, want: 1, found: true},
+		{x: strings.Index(title, "foo"), want: 2, found: true},
+		{x: strings.Index(title, "foo") + len("foo"), want: 0, found: false},
+	}
+	for _, test := range tests {
+		got, found := breadcrumbAt(bcs, test.x)
+		if notEqual(found, test.found) {
+			t.Errorf("x: %d, found got: %v, want: %v", test.x, found, test.found)
+		}
+		if found && notEqual(got, test.want) {
+			t.Errorf("x: %d, got: %d, want: %d", test.x, got, test.want)
+		}
+	}
+}
+
+func TestUpdateListMouse(t *testing.T) {
+	tests := []struct {
+		name   string
+		msgs   func(m menuModel) []tea.MouseMsg
+		index  int
+		opened bool
+	}{
+		{
+			name: "click",
+			msgs: func(m menuModel) []tea.MouseMsg {
+				return []tea.MouseMsg{testClick(0, testMenuItemY(m, 2))}
+			},
+			index:  2,
+			opened: false,
+		},
+		{
+			name: "click the selected",
+			msgs: func(m menuModel) []tea.MouseMsg {
+				return []tea.MouseMsg{testClick(0, testMenuItemY(m, 0))}
+			},
+			index:  0,
+			opened: true,
+		},
+		{
+			name: "click twice",
+			msgs: func(m menuModel) []tea.MouseMsg {
+				return []tea.MouseMsg{testClick(0, testMenuItemY(m, 1)), testClick(0, testMenuItemY(m, 1))}
+			},
+			index:  1,
+			opened: true,
+		},
+		{
+			name: "click above the items",
+			msgs: func(m menuModel) []tea.MouseMsg {
+				return []tea.MouseMsg{testClick(0, m.itemsTop()-1)}
+			},
+			index:  0,
+			opened: false,
+		},
+		{
+			name: "click below the items",
+			msgs: func(m menuModel) []tea.MouseMsg {
+				return []tea.MouseMsg{testClick(0, testMenuItemY(m, len(m.list.Items())))}
+			},
+			index:  0,
+			opened: false,
+		},
+		{
+			name: "wheel",
+			msgs: func(m menuModel) []tea.MouseMsg {
+				return []tea.MouseMsg{
+					{Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress},
+					{Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress},
+					{Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress},
+				}
+			},
+			index:  1,
+			opened: false,
+		},
+	}
+	for _, test := range tests {
+		m := newMenuModel(false)
+		m.SetUser("alice")
+		m.SetSize(80, 40)
+		var cmd tea.Cmd
+		for _, msg := range test.msgs(m) {
+			m, cmd = m.Update(msg)
+		}
+		if got := m.list.Index(); notEqual(got, test.index) {
+			t.Errorf("name: %s, index got: %d, want: %d", test.name, got, test.index)
+		}
+		if got := cmd != nil; notEqual(got, test.opened) {
+			t.Errorf("name: %s, opened got: %v, want: %v", test.name, got, test.opened)
+		}
+	}
+}
+
+func testClick(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+}
+
+// testMenuItemY returns the first line of the item of the menu.
+func testMenuItemY(m menuModel, i int) int {
+	return m.itemsTop() + i*(m.delegate.Height()+m.delegate.Spacing())
+}
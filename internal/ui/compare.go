@@ -0,0 +1,377 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/stats"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	compareErrorStyle = lipgloss.NewStyle().
+				Padding(2, 0, 0, 2).
+				Foreground(theme.Error.Color())
+
+	compareViewportStyle = lipgloss.NewStyle().
+				Padding(1, 0, 0, 2)
+
+	compareUserStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(theme.Selected.Color())
+)
+
+const (
+	// the number of languages shown in the language mixes
+	compareLanguagesCount = 5
+)
+
+// compareUser is the data of one of the compared users.
+type compareUser struct {
+	profile *gh.UserProfile
+	prs     *gh.UserPullRequests
+	repos   *stats.Repositories
+	prStats *stats.PullRequests
+}
+
+type compareModel struct {
+	client *gh.GitHubClient
+
+	keys     statsKeyMap
+	viewport viewport.Model
+	help     help.Model
+	spinner  *spinner.Model
+
+	ids    [2]string
+	users  [2]*compareUser
+	shared []*stats.SharedRepository
+
+	errorMsg      *compareErrorMsg
+	loading       bool
+	width, height int
+}
+
+var compareKeys = registerKeyScope("compare",
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+func newCompareModel(client *gh.GitHubClient, s *spinner.Model) compareModel {
+	keys := statsKeyMap{
+		Back: compareKeys.binding("back"),
+		Quit: compareKeys.binding("quit"),
+	}
+	return compareModel{
+		client:   client,
+		keys:     keys,
+		viewport: viewport.New(0, 0),
+		help:     help.New(),
+		spinner:  s,
+	}
+}
+
+func init() {
+	registerPage(func(ctx *pageContext, _ selectComparePageMsg) page {
+		return newRoutedPage(newCompareModel(ctx.client, ctx.spinner))
+	})
+}
+
+func (m *compareModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	t, r, b, l := compareViewportStyle.GetPadding()
+	m.viewport.Width = width - r - l
+	m.viewport.Height = height - 4 - t - b
+	m.updateContent()
+}
+
+func (m *compareModel) updateContent() {
+	if m.users[0] == nil || m.users[1] == nil {
+		return
+	}
+	m.viewport.SetContent(m.compareContentsView())
+}
+
+func (m compareModel) Init() tea.Cmd {
+	return nil
+}
+
+type compareSuccessMsg struct {
+	users  [2]*compareUser
+	shared []*stats.SharedRepository
+}
+
+var _ tea.Msg = (*compareSuccessMsg)(nil)
+
+type compareErrorMsg struct {
+	e       error
+	summary string
+}
+
+var _ tea.Msg = (*compareErrorMsg)(nil)
+
+func (m compareModel) loadUser(id string, now time.Time) (*compareUser, *compareErrorMsg) {
+	profile, err := m.client.QueryUserProfile(id)
+	if err != nil {
+		return nil, &compareErrorMsg{err, "failed to fetch profile of " + id}
+	}
+	prs, err := m.client.QueryUserPullRequests(id)
+	if err != nil {
+		return nil, &compareErrorMsg{err, "failed to fetch pull requests of " + id}
+	}
+	repos, err := m.client.QueryUserRepositories(id)
+	if err != nil {
+		return nil, &compareErrorMsg{err, "failed to fetch repositories of " + id}
+	}
+	return &compareUser{
+		profile: profile,
+		prs:     prs,
+		repos:   stats.RepositoryStats(repos, now),
+		prStats: stats.PullRequestStats(prs, now),
+	}, nil
+}
+
+// loadUsers fetches the two users in parallel.
+func (m compareModel) loadUsers(ids [2]string) tea.Cmd {
+	return func() tea.Msg {
+		now := time.Now()
+		var users [2]*compareUser
+		var errs [2]*compareErrorMsg
+		var wg sync.WaitGroup
+		for i, id := range ids {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				users[i], errs[i] = m.loadUser(id, now)
+			}(i, id)
+		}
+		wg.Wait()
+		for _, e := range errs {
+			if e != nil {
+				return *e
+			}
+		}
+		return compareSuccessMsg{users, stats.SharedRepositories(users[0].prs, users[1].prs)}
+	}
+}
+
+func (m compareModel) Update(msg tea.Msg) (compareModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			return m, goBackUserSelectPage
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case selectComparePageMsg:
+		m.ids = [2]string{msg.id, msg.other}
+		m.loading = true
+		return m, m.loadUsers(m.ids)
+	case compareSuccessMsg:
+		m.errorMsg = nil
+		m.loading = false
+		m.users = msg.users
+		m.shared = msg.shared
+		m.updateContent()
+		m.viewport.GotoTop()
+		return m, nil
+	case compareErrorMsg:
+		m.errorMsg = &msg
+		m.loading = false
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m compareModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m compareModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
+	}
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	return m.compareView()
+}
+
+func (m compareModel) compareView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	vp := compareViewportStyle.Render(m.viewport.View())
+	ret += vp
+	height -= cn(vp)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m compareModel) columnWidth() int {
+	_, _, _, l := statsSectionStyle.GetPadding()
+	return max((m.viewport.Width-l-statsItemNameStyle.GetWidth())/2-2, 10)
+}
+
+// rowView puts the values of the two users side by side.
+func (m compareModel) rowView(name string, f func(*compareUser) string) string {
+	style := lipgloss.NewStyle().Width(m.columnWidth()).MarginRight(2)
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		statsItemNameStyle.Render(name),
+		style.Render(f(m.users[0])),
+		style.Render(f(m.users[1])),
+	)
+}
+
+func (m compareModel) rowsView(rows ...string) string {
+	return strings.Join(rows, "\n")
+}
+
+func (m compareModel) compareContentsView() string {
+	profile := m.rowsView(
+		m.rowView("", func(u *compareUser) string { return compareUserStyle.Render(u.profile.Login) }),
+		m.rowView("Name", func(u *compareUser) string { return compareTextView(u.profile.Name) }),
+		m.rowView("Company", func(u *compareUser) string { return compareTextView(u.profile.Company) }),
+		m.rowView("Location", func(u *compareUser) string { return compareTextView(u.profile.Location) }),
+		m.rowView("Followers", func(u *compareUser) string { return fmt.Sprint(u.profile.Followers) }),
+		m.rowView("Following", func(u *compareUser) string { return fmt.Sprint(u.profile.Following) }),
+	)
+	repos := m.rowsView(
+		m.rowView("Repositories", func(u *compareUser) string { return fmt.Sprint(u.repos.Total) }),
+		m.rowView("Stars", func(u *compareUser) string { return fmt.Sprint(u.repos.Stars) }),
+		m.rowView("Forks", func(u *compareUser) string { return fmt.Sprint(u.repos.Forks) }),
+		m.rowView("Languages", func(u *compareUser) string { return compareShareView(u.repos.LanguagesByCount) }),
+	)
+	prs := m.rowsView(
+		m.rowView("Pull Requests", func(u *compareUser) string { return fmt.Sprint(u.prStats.Total) }),
+		m.rowView("Merged", func(u *compareUser) string { return fmt.Sprint(u.prStats.Merged) }),
+		m.rowView("Merge rate", func(u *compareUser) string { return fmt.Sprintf("%.1f%%", u.prStats.MergeRate()*100) }),
+		m.rowView("Time to merge", func(u *compareUser) string { return statsMedianView(u.prStats.MedianTimeToMerge) }),
+		m.rowView("Languages", func(u *compareUser) string { return compareShareView(u.prStats.Languages) }),
+	)
+
+	ret := ""
+	ret += statsSectionView("Profile", profile)
+	ret += statsSectionView("Repositories", repos)
+	ret += statsSectionView("Pull Requests", prs)
+	ret += statsSectionView("Shared repositories (pull requests)", m.sharedView())
+	return ret
+}
+
+func (m compareModel) sharedView() string {
+	if len(m.shared) == 0 {
+		return ""
+	}
+	nameWidth := 0
+	for _, r := range m.shared {
+		nameWidth = max(nameWidth, lipgloss.Width(r.Name))
+	}
+	nameWidth = min(nameWidth, m.viewport.Width/2)
+	lines := make([]string, len(m.shared))
+	for i, r := range m.shared {
+		name := r.Name
+		if lipgloss.Width(name) > nameWidth {
+			name = truncate.StringWithTail(name, uint(nameWidth), "…")
+		}
+		lines[i] = fmt.Sprintf("%-*s  %s %d  %s %d",
+			nameWidth, name,
+			chartLabelStyle.Render(m.ids[0]), r.A,
+			chartLabelStyle.Render(m.ids[1]), r.B,
+		)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func compareTextView(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// compareShareView shows the top languages with their shares of the total.
+func compareShareView(counts []stats.Count) string {
+	if len(counts) == 0 {
+		return "-"
+	}
+	total := 0
+	for _, c := range counts {
+		total += c.Count
+	}
+	top := counts[:min(len(counts), compareLanguagesCount)]
+	nameWidth := 0
+	for _, c := range top {
+		nameWidth = max(nameWidth, lipgloss.Width(c.Name))
+	}
+	lines := make([]string, len(top))
+	for i, c := range top {
+		share := 0.0
+		if total > 0 {
+			share = float64(c.Count) / float64(total) * 100
+		}
+		lines[i] = fmt.Sprintf("%-*s %s", nameWidth, c.Name, chartLabelStyle.Render(fmt.Sprintf("%3.0f%%", share)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m compareModel) errorView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	errorText := compareErrorStyle.Render("ERROR: " + m.errorMsg.summary)
+	ret += errorText
+	height -= cn(errorText)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m compareModel) breadcrumb() []string {
+	return []string{m.ids[0] + " vs " + m.ids[1], "Compare"}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 var (
@@ -20,7 +21,7 @@ var (
 	creditsUrlStyle = urlTextStyle.Copy()
 
 	creditsSeparatorStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("240"))
+				Foreground(theme.Muted.Color())
 
 	creditsSeparator = "----------------------------------------"
 )
@@ -30,6 +31,11 @@ type creditsKeyMap struct {
 	Quit key.Binding
 }
 
+var creditsKeys = registerKeyScope("credits",
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
 func (k creditsKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.Back,
@@ -59,14 +65,8 @@ type creditsModel struct {
 
 func newCreditsModel() creditsModel {
 	keys := creditsKeyMap{
-		Back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		Quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		Back: creditsKeys.binding("back"),
+		Quit: creditsKeys.binding("quit"),
 	}
 
 	contents := ""
@@ -87,6 +87,12 @@ func newCreditsModel() creditsModel {
 	}
 }
 
+func init() {
+	registerPage(func(*pageContext, selectCreditsPageMsg) page {
+		return newRoutedPage(newCreditsModel())
+	})
+}
+
 func (m *creditsModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
@@ -105,7 +111,7 @@ func (m creditsModel) Update(msg tea.Msg) (creditsModel, tea.Cmd) {
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.keys.Back):
-			return m, goBackHelpPage
+			return m, goBack
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
 		}
@@ -117,6 +123,10 @@ func (m creditsModel) Update(msg tea.Msg) (creditsModel, tea.Cmd) {
 	return m, cmd
 }
 
+func (m creditsModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
 func (m creditsModel) View() string {
 	if m.height <= 0 {
 		return ""
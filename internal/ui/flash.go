@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+var (
+	flashStyle = lipgloss.NewStyle().
+			Foreground(theme.Selected.Color()).
+			Padding(0, 0, 0, 2)
+
+	flashErrorStyle = flashStyle.Copy().
+			Foreground(theme.Error.Color())
+)
+
+const (
+	// how long a flash is shown
+	flashDuration = 2 * time.Second
+	// a warning is shown longer, to be read
+	flashWarningDuration = 5 * time.Second
+)
+
+type flashExpiredMsg struct {
+	seq int
+}
+
+var _ tea.Msg = (*flashExpiredMsg)(nil)
+
+func flashExpired(seq int, d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return flashExpiredMsg{seq}
+	})
+}
+
+// warningMsg shows the warning as a flash, such as the settings ignored at startup.
+type warningMsg struct {
+	warning string
+}
+
+var _ tea.Msg = (*warningMsg)(nil)
+
+func warn(warning string) tea.Cmd {
+	return func() tea.Msg { return warningMsg{warning} }
+}
+
+// withFlash shows the flash in place of the last line of the view, which is the help of the page.
+func withFlash(view, flash string) string {
+	lines := strings.Split(view, "\n")
+	if len(lines) < 2 {
+		return view
+	}
+	lines[len(lines)-1] = flash
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,585 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	followsErrorStyle = lipgloss.NewStyle().
+				Padding(2, 0, 0, 2).
+				Foreground(theme.Error.Color())
+
+	followsTableStyle = lipgloss.NewStyle().
+				Padding(1, 0, 0, 2)
+
+	followsStatusStyle = lipgloss.NewStyle().
+				Padding(2, 0, 0, 2).
+				Foreground(theme.Dimmed.Color())
+
+	followsSelectedStyle = lipgloss.NewStyle().
+				Foreground(theme.Selected.Color())
+
+	followsBioStyle = lipgloss.NewStyle().
+			Foreground(theme.Muted.Color())
+)
+
+const (
+	// the next page is loaded when the cursor comes this close to the end
+	followsLoadMoreMargin = 5
+	// the mutual filter fetches at most this number of users of each list
+	followsMutualLimit = 2000
+	// longer names are truncated to keep the columns aligned
+	followsNameMaxWidth = 24
+)
+
+type followsKind int
+
+const (
+	followersKind followsKind = iota
+	followingKind
+)
+
+func (k followsKind) String() string {
+	if k == followingKind {
+		return "Following"
+	}
+	return "Followers"
+}
+
+func (k followsKind) other() followsKind {
+	if k == followingKind {
+		return followersKind
+	}
+	return followingKind
+}
+
+type followsKeyMap struct {
+	Down   key.Binding
+	Up     key.Binding
+	Select key.Binding
+	Switch key.Binding
+	Mutual key.Binding
+	Open   key.Binding
+	Back   key.Binding
+	Quit   key.Binding
+}
+
+var followsKeys = registerKeyScope("follows",
+	newKeyAction("down", "↓/j", "down", "j", "down"),
+	newKeyAction("up", "↑/k", "up", "k", "up"),
+	newKeyAction("select", "enter", "show user", "enter"),
+	newKeyAction("switch", "tab", "followers/following", "tab"),
+	newKeyAction("mutual", "m", "mutual only", "m"),
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+func (k followsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Down,
+		k.Up,
+		k.Select,
+		k.Switch,
+		k.Mutual,
+		k.Open,
+		k.Back,
+		k.Quit,
+	}
+}
+
+func (k followsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			k.Down,
+			k.Up,
+		},
+		{
+			k.Select,
+			k.Open,
+		},
+		{
+			k.Switch,
+			k.Mutual,
+		},
+		{
+			k.Back,
+			k.Quit,
+		},
+	}
+}
+
+type followsModel struct {
+	client *gh.GitHubClient
+
+	keys    followsKeyMap
+	help    help.Model
+	spinner *spinner.Model
+
+	kind        followsKind
+	users       []*gh.FollowUser
+	totalCount  int
+	endCursor   string
+	hasNextPage bool
+	loadingMore bool
+
+	// mutual shows only the users in both lists, which requires the whole lists
+	mutual        bool
+	mutualLogins  map[string]bool // logins in the other list
+	mutualLimited bool            // the lists were too long to fetch completely
+
+	listCursor
+
+	errorMsg      *followsErrorMsg
+	loading       bool
+	selectedUser  string
+	width, height int
+}
+
+func newFollowsModel(client *gh.GitHubClient, s *spinner.Model) followsModel {
+	keys := followsKeyMap{
+		Down:   followsKeys.binding("down"),
+		Up:     followsKeys.binding("up"),
+		Select: followsKeys.binding("select"),
+		Switch: followsKeys.binding("switch"),
+		Mutual: followsKeys.binding("mutual"),
+		Open:   followsKeys.binding("open"),
+		Back:   followsKeys.binding("back"),
+		Quit:   followsKeys.binding("quit"),
+	}
+	return followsModel{
+		client:  client,
+		keys:    keys,
+		help:    help.New(),
+		spinner: s,
+	}
+}
+
+func init() {
+	registerPage(func(ctx *pageContext, _ selectFollowsPageMsg) page {
+		m := newFollowsModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+}
+
+func (m *followsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	m.scroll()
+}
+
+func (m *followsModel) SetUser(id string) {
+	m.selectedUser = id
+}
+
+func (m *followsModel) reset(kind followsKind) {
+	m.kind = kind
+	m.users = nil
+	m.totalCount = 0
+	m.endCursor = ""
+	m.hasNextPage = false
+	m.loadingMore = false
+	m.mutual = false
+	m.mutualLogins = nil
+	m.mutualLimited = false
+	m.listCursor.reset()
+}
+
+// visibleUsers returns the users shown in the list, applying the mutual filter.
+func (m *followsModel) visibleUsers() []*gh.FollowUser {
+	if !m.mutual {
+		return m.users
+	}
+	ret := make([]*gh.FollowUser, 0)
+	for _, u := range m.users {
+		if m.mutualLogins[strings.ToLower(u.Login)] {
+			ret = append(ret, u)
+		}
+	}
+	return ret
+}
+
+func (m *followsModel) selectedFollowUser() *gh.FollowUser {
+	users := m.visibleUsers()
+	if m.cursor < len(users) {
+		return users[m.cursor]
+	}
+	return nil
+}
+
+func (m *followsModel) visibleRows() int {
+	t, _, b, _ := followsTableStyle.GetPadding()
+	st, _, sb, _ := followsStatusStyle.GetPadding()
+	// title and help
+	return max(m.height-3-t-b-st-sb, 1)
+}
+
+// rowsTop returns the line of the first row of the table, whose padding follows the title.
+func (m followsModel) rowsTop() int {
+	t, _, _, _ := followsTableStyle.GetPadding()
+	return 1 + t
+}
+
+func (m *followsModel) scroll() {
+	m.scrollToCursor(m.visibleRows(), len(m.visibleUsers()))
+}
+
+func (m *followsModel) move(delta int) tea.Cmd {
+	users := m.visibleUsers()
+	if len(users) == 0 {
+		return nil
+	}
+	m.listCursor.move(delta, len(users), false)
+	m.scroll()
+	if !m.mutual && m.hasNextPage && !m.loadingMore && m.cursor >= len(users)-followsLoadMoreMargin {
+		m.loadingMore = true
+		return m.loadFollows(m.selectedUser, m.kind, m.endCursor)
+	}
+	return nil
+}
+
+func (m followsModel) Init() tea.Cmd {
+	return nil
+}
+
+type followsSuccessMsg struct {
+	id      string
+	kind    followsKind
+	follows *gh.Follows
+}
+
+var _ tea.Msg = (*followsSuccessMsg)(nil)
+
+type followsMutualSuccessMsg struct {
+	id      string
+	kind    followsKind
+	follows *gh.Follows // the users fetched so far, with the cursor to continue
+	others  map[string]bool
+	limited bool
+}
+
+var _ tea.Msg = (*followsMutualSuccessMsg)(nil)
+
+type followsErrorMsg struct {
+	e       error
+	summary string
+}
+
+var _ tea.Msg = (*followsErrorMsg)(nil)
+
+func (m followsModel) queryFollows(id string, kind followsKind, cursorAfter string) (*gh.Follows, error) {
+	if kind == followingKind {
+		return m.client.QueryFollowing(id, cursorAfter)
+	}
+	return m.client.QueryFollowers(id, cursorAfter)
+}
+
+func (m followsModel) loadFollows(id string, kind followsKind, cursorAfter string) tea.Cmd {
+	return func() tea.Msg {
+		follows, err := m.queryFollows(id, kind, cursorAfter)
+		if err != nil {
+			return followsErrorMsg{err, "failed to fetch " + strings.ToLower(kind.String())}
+		}
+		return followsSuccessMsg{id, kind, follows}
+	}
+}
+
+// queryAllFollows fetches the rest of the list after the users already loaded, up to followsMutualLimit.
+func (m followsModel) queryAllFollows(id string, kind followsKind, loaded *gh.Follows) (*gh.Follows, error) {
+	ret := *loaded
+	ret.Users = append([]*gh.FollowUser{}, loaded.Users...)
+	for ret.HasNextPage && len(ret.Users) < followsMutualLimit {
+		follows, err := m.queryFollows(id, kind, ret.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		ret.TotalCount = follows.TotalCount
+		ret.Users = append(ret.Users, follows.Users...)
+		ret.EndCursor = follows.EndCursor
+		ret.HasNextPage = follows.HasNextPage
+	}
+	return &ret, nil
+}
+
+func (m followsModel) loadMutual() tea.Cmd {
+	id, kind := m.selectedUser, m.kind
+	loaded := &gh.Follows{TotalCount: m.totalCount, Users: m.users, EndCursor: m.endCursor, HasNextPage: m.hasNextPage}
+	return func() tea.Msg {
+		follows, err := m.queryAllFollows(id, kind, loaded)
+		if err != nil {
+			return followsErrorMsg{err, "failed to fetch " + strings.ToLower(kind.String())}
+		}
+		others, err := m.queryAllFollows(id, kind.other(), &gh.Follows{HasNextPage: true})
+		if err != nil {
+			return followsErrorMsg{err, "failed to fetch " + strings.ToLower(kind.other().String())}
+		}
+		logins := make(map[string]bool)
+		for _, u := range others.Users {
+			logins[strings.ToLower(u.Login)] = true
+		}
+		return followsMutualSuccessMsg{id, kind, follows, logins, follows.HasNextPage || others.HasNextPage}
+	}
+}
+
+func (m followsModel) openInBrowser(u *gh.FollowUser) tea.Cmd {
+	return func() tea.Msg {
+		if err := openBrowser(u.Url); err != nil {
+			return followsErrorMsg{err, "failed to open browser"}
+		}
+		return nil
+	}
+}
+
+func (m followsModel) Update(msg tea.Msg) (followsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.Down):
+			return m, m.move(1)
+		case key.Matches(msg, m.keys.Up):
+			return m, m.move(-1)
+		case key.Matches(msg, m.keys.Select):
+			if u := m.selectedFollowUser(); u != nil {
+				return m, userSelected(u.Login)
+			}
+		case key.Matches(msg, m.keys.Switch):
+			m.errorMsg = nil
+			m.reset(m.kind.other())
+			m.loading = true
+			return m, m.loadFollows(m.selectedUser, m.kind, "")
+		case key.Matches(msg, m.keys.Mutual):
+			m.errorMsg = nil
+			m.listCursor.reset()
+			if m.mutual || m.mutualLogins != nil {
+				m.mutual = !m.mutual
+				return m, m.move(0)
+			}
+			m.loading = true
+			return m, m.loadMutual()
+		case key.Matches(msg, m.keys.Open):
+			if u := m.selectedFollowUser(); u != nil {
+				return m, m.openInBrowser(u)
+			}
+		case key.Matches(msg, m.keys.Back):
+			if m.errorMsg != nil && len(m.users) > 0 {
+				m.errorMsg = nil
+				return m, nil
+			}
+			return m, goBack
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case tea.MouseMsg:
+		if m.loading || m.errorMsg != nil {
+			return m, nil
+		}
+		if d := wheelDelta(msg); d != 0 {
+			return m, m.move(d)
+		}
+		if row := clickedRow(msg, m.rowsTop()); 0 <= row && row < m.visibleRows() {
+			i := m.offset + row
+			if i == m.cursor {
+				return m.Update(keyPress(m.keys.Select))
+			}
+			if i < len(m.visibleUsers()) {
+				return m, m.move(i - m.cursor)
+			}
+		}
+		return m, nil
+	case selectFollowsPageMsg:
+		m.errorMsg = nil
+		m.reset(msg.kind)
+		m.loading = true
+		return m, m.loadFollows(msg.id, msg.kind, "")
+	case followsSuccessMsg:
+		// the response of the list no longer shown is discarded
+		if msg.id != m.selectedUser || msg.kind != m.kind {
+			return m, nil
+		}
+		m.errorMsg = nil
+		m.loading = false
+		m.loadingMore = false
+		m.users = append(m.users, msg.follows.Users...)
+		m.totalCount = msg.follows.TotalCount
+		m.endCursor = msg.follows.EndCursor
+		m.hasNextPage = msg.follows.HasNextPage
+		return m, nil
+	case followsMutualSuccessMsg:
+		if msg.id != m.selectedUser || msg.kind != m.kind {
+			return m, nil
+		}
+		m.errorMsg = nil
+		m.loading = false
+		m.users = msg.follows.Users
+		m.totalCount = msg.follows.TotalCount
+		m.endCursor = msg.follows.EndCursor
+		m.hasNextPage = msg.follows.HasNextPage
+		m.mutual = true
+		m.mutualLogins = msg.others
+		m.mutualLimited = msg.limited
+		return m, m.move(0)
+	case followsErrorMsg:
+		m.errorMsg = &msg
+		m.loading = false
+		m.loadingMore = false
+		return m, nil
+	}
+	return m, nil
+}
+
+func (m followsModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m followsModel) yankTargets() (*yankTarget, []*yankTarget) {
+	if m.loading || m.errorMsg != nil {
+		return nil, nil
+	}
+	var selected *yankTarget
+	if u := m.selectedFollowUser(); u != nil {
+		selected = userYankTarget(u.Login)
+	}
+	users := m.visibleUsers()
+	visible := make([]*yankTarget, len(users))
+	for i, u := range users {
+		visible[i] = userYankTarget(u.Login)
+	}
+	return selected, visible
+}
+
+func (m followsModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
+	}
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	return m.followsView()
+}
+
+func (m followsModel) followsView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	table := followsTableStyle.Render(m.tableView())
+	ret += table
+	height -= cn(table)
+
+	status := followsStatusStyle.Render(m.statusView())
+	ret += status
+	height -= cn(status)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", max(height, 0))
+	ret += help
+
+	return ret
+}
+
+func (m followsModel) statusView() string {
+	users := m.visibleUsers()
+	if m.mutual {
+		s := fmt.Sprintf("%d mutual", len(users))
+		if m.mutualLimited {
+			s += fmt.Sprintf(" (in the first %d users)", followsMutualLimit)
+		}
+		return s
+	}
+	s := fmt.Sprintf("%d / %d %s", len(users), m.totalCount, strings.ToLower(m.kind.String()))
+	if m.loadingMore {
+		s += " " + m.spinner.View()
+	}
+	return s
+}
+
+func (m followsModel) tableView() string {
+	users := m.visibleUsers()
+	if len(users) == 0 {
+		if m.mutual {
+			return "No mutual followers"
+		}
+		return "No users"
+	}
+	_, r, _, l := followsTableStyle.GetPadding()
+	width := m.width - r - l
+
+	end := min(m.offset+m.visibleRows(), len(users))
+	loginWidth, nameWidth := 0, 0
+	for _, u := range users[m.offset:end] {
+		loginWidth = max(loginWidth, lipgloss.Width(u.Login))
+		nameWidth = max(nameWidth, min(lipgloss.Width(u.Name), followsNameMaxWidth))
+	}
+
+	lines := make([]string, 0, end-m.offset)
+	for i := m.offset; i < end; i++ {
+		u := users[i]
+		name := u.Name
+		if lipgloss.Width(name) > nameWidth {
+			name = truncate.StringWithTail(name, uint(nameWidth), "…")
+		}
+		line := fmt.Sprintf("  %-*s  %-*s  %7d followers", loginWidth, u.Login, nameWidth, name, u.Followers)
+		if bio := strings.Join(strings.Fields(u.Bio), " "); bio != "" {
+			line += "  " + followsBioStyle.Render(bio)
+		}
+		line = truncate.StringWithTail(line, uint(width), "…")
+		if i == m.cursor {
+			line = followsSelectedStyle.Render(">") + line[1:]
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m followsModel) errorView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	errorText := followsErrorStyle.Render("ERROR: " + m.errorMsg.summary)
+	ret += errorText
+	height -= cn(errorText)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", max(height, 0))
+	ret += help
+
+	return ret
+}
+
+func (m followsModel) breadcrumb() []string {
+	return []string{m.selectedUser, m.kind.String()}
+}
@@ -7,13 +7,15 @@ import (
 )
 
 const (
-	helpTitleAbout   = "About"
-	helpTitleCredits = "Credits"
+	helpTitleKeybindings = "Keybindings"
+	helpTitleAbout       = "About"
+	helpTitleCredits     = "Credits"
 )
 
 type helpModel struct {
 	list list.Model
 
+	delegate     list.DefaultDelegate
 	delegateKeys helpDelegateKeyMap
 
 	width, height int
@@ -40,6 +42,10 @@ func (i helpItem) FilterValue() string {
 
 func newHelpModel() helpModel {
 	items := []list.Item{
+		helpItem{
+			title: helpTitleKeybindings,
+			desc:  "Show the keys of every page",
+		},
 		helpItem{
 			title: helpTitleAbout,
 			desc:  "Show about this application",
@@ -60,38 +66,41 @@ func newHelpModel() helpModel {
 		return [][]key.Binding{{delegateKeys.sel, delegateKeys.back}}
 	}
 
-	// bubbles/list/defaultitem.go
-	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.Copy().Foreground(selectedColor1).BorderForeground(selectedColor2)
-	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.Copy().Foreground(selectedColor2).BorderForeground(selectedColor2)
+	delegate.Styles = newListItemStyles()
 	l := list.New(items, delegate, 0, 0)
-	l.KeyMap.Quit = key.NewBinding(
-		key.WithKeys("ctrl+c", "esc"),
-		key.WithHelp("ctrl+c", "quit"),
-	)
+	l.KeyMap.Quit = helpKeys.binding("quit")
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 	return helpModel{
 		list:         l,
+		delegate:     delegate,
 		delegateKeys: delegateKeys,
 	}
 }
 
+func init() {
+	registerPage(func(*pageContext, selectHelpPageMsg) page {
+		return newRoutedPage(newHelpModel())
+	})
+}
+
 type helpDelegateKeyMap struct {
 	back key.Binding
 	sel  key.Binding
 }
 
+var helpKeys = registerKeyScope("help",
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("select", "enter", "select", "enter"),
+).with("list")
+
 func newHelpDelegateKeyMap() helpDelegateKeyMap {
 	return helpDelegateKeyMap{
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		sel: key.NewBinding(
-			key.WithKeys("enter"),
-			key.WithHelp("enter", "select"),
-		),
+		back: helpKeys.binding("back"),
+		sel:  helpKeys.binding("select"),
 	}
 }
 
@@ -105,22 +114,36 @@ func (m helpModel) Init() tea.Cmd {
 	return nil
 }
 
+func (m helpModel) capturingInput() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
 func (m helpModel) Update(msg tea.Msg) (helpModel, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0)
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
 		switch {
 		case key.Matches(msg, m.delegateKeys.sel):
 			switch m.list.SelectedItem().(helpItem).Title() {
+			case helpTitleKeybindings:
+				return m, selectKeybindingsPage
 			case helpTitleAbout:
 				return m, selectAboutPage
 			case helpTitleCredits:
 				return m, selectCreditsPage
 			}
 		case key.Matches(msg, m.delegateKeys.back):
-			return m, goBackMenuPage
+			return m, goBack
+		}
+	case tea.MouseMsg:
+		if updateListMouse(&m.list, m.delegate, msg, m.itemsTop()) {
+			return m.Update(keyPress(m.delegateKeys.sel))
 		}
+		return m, nil
 	case selectHelpPageMsg:
 		m.list.ResetSelected()
 	}
@@ -132,6 +155,15 @@ func (m helpModel) Update(msg tea.Msg) (helpModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+func (m helpModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
+}
+
+func (m helpModel) itemsTop() int {
+	// below the title
+	return 2 + listItemsOffset(m.list)
+}
+
 func (m helpModel) View() string {
 	return titleView(m.breadcrumb()) + listView(m.list)
 }
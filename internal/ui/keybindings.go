@@ -0,0 +1,426 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	keybindingsViewportStyle = lipgloss.NewStyle().
+					Padding(1, 0, 0, 2)
+
+	keybindingsSectionStyle = lipgloss.NewStyle().
+				Bold(true)
+
+	keybindingsKeyStyle = lipgloss.NewStyle().
+				Foreground(theme.Selected.Color())
+
+	keybindingsActionStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color())
+
+	keybindingsSearchStyle = lipgloss.NewStyle().
+				Padding(0, 0, 0, 2)
+)
+
+const keybindingsKeyMaxWidth = 24
+
+// keyScopeTitles are the titles of the scopes in the keybindings page, in the order they are shown.
+// The scopes which are not listed here are shown after them by their names.
+var keyScopeTitles = []struct {
+	name  string
+	title string
+}{
+	{appKeyScopeName, "Global"},
+	{"list", "Lists"},
+	{"palette", "Command Palette"},
+	{"user", "User"},
+	{"compare", "Compare"},
+	{"menu", "Menu"},
+	{"profile", "Profile"},
+	{"prs-owner", "Pull Requests (owners)"},
+	{"prs-repo", "Pull Requests (repositories)"},
+	{"prs-list", "Pull Requests"},
+	{"prs-all", "Pull Requests (all)"},
+	{"pr-stats", "PR Stats"},
+	{"repositories", "Repositories"},
+	{"repo-stats", "Repo Stats"},
+	{"timeline", "Timeline"},
+	{"follows", "Follows"},
+	{"team", "Teams"},
+	{"preview", "Preview"},
+	{"table", "Table"},
+	{"query-prompt", "Query"},
+	{"sort-dialog", "Sort Dialog"},
+	{"select-dialog", "Filter Dialog"},
+	{"saved-views", "Saved Views"},
+	{"saved-views-input", "Saved Views (name)"},
+	{"help", "Help"},
+	{"about", "About"},
+	{"credits", "Credits"},
+	{"keybindings", "Keybindings"},
+	{"keybindings-search", "Keybindings (search)"},
+}
+
+// keybindingsSection is the keys of a scope, with the keys remapped by the keymap file.
+type keybindingsSection struct {
+	name  string
+	title string
+	rows  []keybindingsRow
+}
+
+type keybindingsRow struct {
+	keys   string
+	desc   string
+	action string // the name in the keymap file
+}
+
+func (r keybindingsRow) matches(s string) bool {
+	return strings.Contains(strings.ToLower(r.keys), s) ||
+		strings.Contains(strings.ToLower(r.desc), s) ||
+		strings.Contains(strings.ToLower(r.action), s)
+}
+
+// keybindingsSections returns the keys of all the scopes, so that the page stays correct as the bindings change.
+func keybindingsSections() []keybindingsSection {
+	names := make([]string, 0, len(keyScopes))
+	titles := make(map[string]string)
+	for _, t := range keyScopeTitles {
+		if _, ok := keyScopes[t.name]; ok {
+			names = append(names, t.name)
+			titles[t.name] = t.title
+		}
+	}
+	for _, name := range sortedKeys(keyScopes) {
+		if _, ok := titles[name]; !ok {
+			names = append(names, name)
+			titles[name] = name
+		}
+	}
+
+	sections := make([]keybindingsSection, 0, len(names))
+	for _, name := range names {
+		s := keyScopes[name]
+		rows := make([]keybindingsRow, 0, len(s.actions))
+		for _, a := range s.actions {
+			keys, _ := s.keys(a.name)
+			names := make([]string, len(keys))
+			for i, k := range keys {
+				names[i] = keyHelp([]string{k})
+			}
+			rows = append(rows, keybindingsRow{
+				keys:   strings.Join(names, ", "),
+				desc:   a.desc,
+				action: name + "." + a.name,
+			})
+		}
+		sections = append(sections, keybindingsSection{
+			name:  name,
+			title: titles[name],
+			rows:  rows,
+		})
+	}
+	return sections
+}
+
+// filterKeybindingsSections returns the sections whose title matches, and the keys which match in the others.
+func filterKeybindingsSections(sections []keybindingsSection, search string) []keybindingsSection {
+	search = strings.ToLower(strings.TrimSpace(search))
+	if search == "" {
+		return sections
+	}
+	ret := make([]keybindingsSection, 0)
+	for _, s := range sections {
+		if strings.Contains(strings.ToLower(s.title), search) || strings.Contains(s.name, search) {
+			ret = append(ret, s)
+			continue
+		}
+		rows := make([]keybindingsRow, 0)
+		for _, r := range s.rows {
+			if r.matches(search) {
+				rows = append(rows, r)
+			}
+		}
+		if len(rows) > 0 {
+			ret = append(ret, keybindingsSection{name: s.name, title: s.title, rows: rows})
+		}
+	}
+	return ret
+}
+
+type keybindingsKeyMap struct {
+	Search key.Binding
+	Back   key.Binding
+	Quit   key.Binding
+}
+
+var keybindingsKeys = registerKeyScope("keybindings",
+	newKeyAction("search", "/", "search", "/"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+var keybindingsSearchKeys = registerKeyScope("keybindings-search",
+	newKeyAction("apply", "enter", "apply search", "enter"),
+	newKeyAction("cancel", "esc", "cancel search", "esc"),
+)
+
+func (k keybindingsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Search,
+		k.Back,
+		k.Quit,
+	}
+}
+
+func (k keybindingsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			k.Search,
+		},
+		{
+			k.Back,
+		},
+		{
+			k.Quit,
+		},
+	}
+}
+
+type keybindingsSearchKeyMap struct {
+	Apply  key.Binding
+	Cancel key.Binding
+}
+
+func (k keybindingsSearchKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Apply,
+		k.Cancel,
+	}
+}
+
+func (k keybindingsSearchKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			k.Apply,
+		},
+		{
+			k.Cancel,
+		},
+	}
+}
+
+type keybindingsModel struct {
+	viewport viewport.Model
+	input    textinput.Model
+	// the search is being edited
+	searching bool
+
+	sections []keybindingsSection
+
+	keys       keybindingsKeyMap
+	searchKeys keybindingsSearchKeyMap
+	help       help.Model
+
+	width, height int
+}
+
+func newKeybindingsModel() keybindingsModel {
+	keys := keybindingsKeyMap{
+		Search: keybindingsKeys.binding("search"),
+		Back:   keybindingsKeys.binding("back"),
+		Quit:   keybindingsKeys.binding("quit"),
+	}
+	searchKeys := keybindingsSearchKeyMap{
+		Apply:  keybindingsSearchKeys.binding("apply"),
+		Cancel: keybindingsSearchKeys.binding("cancel"),
+	}
+
+	input := textinput.New()
+	input.Prompt = "Search: "
+	input.Placeholder = "key, action or page"
+
+	return keybindingsModel{
+		viewport:   viewport.New(0, 0),
+		input:      input,
+		sections:   keybindingsSections(),
+		keys:       keys,
+		searchKeys: searchKeys,
+		help:       help.New(),
+	}
+}
+
+func init() {
+	registerPage(func(*pageContext, selectKeybindingsPageMsg) page {
+		return newRoutedPage(newKeybindingsModel())
+	})
+}
+
+func (m *keybindingsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	t, r, b, l := keybindingsViewportStyle.GetPadding()
+	m.viewport.Width = width - r - l
+	// the title, the search and the help
+	m.viewport.Height = height - 4 - t - b
+	m.input.Width = width - keybindingsSearchStyle.GetHorizontalPadding() - lipgloss.Width(m.input.Prompt) - 1
+	m.updateContent()
+}
+
+func (m *keybindingsModel) updateContent() {
+	m.viewport.SetContent(m.contentView())
+}
+
+func (m keybindingsModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m keybindingsModel) capturingInput() bool {
+	return m.searching
+}
+
+func (m keybindingsModel) Update(msg tea.Msg) (keybindingsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		switch {
+		case key.Matches(msg, m.keys.Search):
+			m.searching = true
+			return m, m.input.Focus()
+		case key.Matches(msg, m.keys.Back):
+			return m, goBack
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case selectKeybindingsPageMsg:
+		m.viewport.GotoTop()
+	}
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m keybindingsModel) updateSearch(msg tea.KeyMsg) (keybindingsModel, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.searchKeys.Apply):
+		m.searching = false
+		m.input.Blur()
+		return m, nil
+	case key.Matches(msg, m.searchKeys.Cancel):
+		m.searching = false
+		m.input.Blur()
+		m.input.Reset()
+		m.updateContent()
+		m.viewport.GotoTop()
+		return m, nil
+	}
+	value := m.input.Value()
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != value {
+		m.updateContent()
+		m.viewport.GotoTop()
+	}
+	return m, cmd
+}
+
+func (m keybindingsModel) keyBindings() [][]key.Binding {
+	if m.searching {
+		return m.searchKeys.FullHelp()
+	}
+	return m.keys.FullHelp()
+}
+
+func (m keybindingsModel) View() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	content := keybindingsViewportStyle.Render(m.viewport.View())
+	ret += content
+	height -= cn(content)
+
+	search := "\n" + m.searchView()
+	ret += search
+	height -= cn(search)
+
+	var help string
+	if m.searching {
+		help = helpStyle.Render(m.help.View(m.searchKeys))
+	} else {
+		help = helpStyle.Render(m.help.View(m.keys))
+	}
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", max(height, 0))
+	ret += help
+
+	return ret
+}
+
+func (m keybindingsModel) searchView() string {
+	if m.searching {
+		return keybindingsSearchStyle.Render(m.input.View())
+	}
+	if m.input.Value() != "" {
+		return keybindingsSearchStyle.Render(keybindingsActionStyle.Render(m.input.Prompt) + m.input.Value())
+	}
+	return ""
+}
+
+func (m keybindingsModel) contentView() string {
+	sections := filterKeybindingsSections(m.sections, m.input.Value())
+	if len(sections) == 0 {
+		return keybindingsActionStyle.Render("No keys match")
+	}
+
+	keyWidth, descWidth := 0, 0
+	for _, s := range sections {
+		for _, r := range s.rows {
+			keyWidth = max(keyWidth, lipgloss.Width(r.keys))
+			descWidth = max(descWidth, lipgloss.Width(r.desc))
+		}
+	}
+	keyWidth = min(keyWidth, keybindingsKeyMaxWidth)
+
+	lines := make([]string, 0)
+	for i, s := range sections {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, keybindingsSectionStyle.Render(s.title))
+		for _, r := range s.rows {
+			keys := r.keys
+			if lipgloss.Width(keys) > keyWidth {
+				keys = truncate.StringWithTail(keys, uint(keyWidth), ellipsis)
+			}
+			line := "  " + keybindingsKeyStyle.Render(lipgloss.PlaceHorizontal(keyWidth, lipgloss.Left, keys)) + "  " + lipgloss.PlaceHorizontal(descWidth, lipgloss.Left, r.desc) + "  " + keybindingsActionStyle.Render(r.action)
+			if lipgloss.Width(line) > m.viewport.Width {
+				line = truncate.StringWithTail(line, uint(max(m.viewport.Width, 0)), ellipsis)
+			}
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m keybindingsModel) breadcrumb() []string {
+	return []string{"Help", "Keybindings"}
+}
@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func findKeybindingsRow(sections []keybindingsSection, action string) (keybindingsRow, bool) {
+	for _, s := range sections {
+		for _, r := range s.rows {
+			if r.action == action {
+				return r, true
+			}
+		}
+	}
+	return keybindingsRow{}, false
+}
+
+func TestKeybindingsSections(t *testing.T) {
+	sections := keybindingsSections()
+
+	// every scope is listed once, the titled ones first
+	names := make([]string, len(sections))
+	for i, s := range sections {
+		names[i] = s.name
+	}
+	if got, want := len(names), len(keyScopes); notEqual(got, want) {
+		t.Errorf("sections got: %d, want: %d", got, want)
+	}
+	for _, name := range sortedKeys(keyScopes) {
+		n := 0
+		for _, s := range names {
+			if s == name {
+				n++
+			}
+		}
+		if n != 1 {
+			t.Errorf("scope %s is listed %d times", name, n)
+		}
+	}
+	if got, want := sections[0].title, "Global"; notEqual(got, want) {
+		t.Errorf("first section got: %s, want: %s", got, want)
+	}
+	for _, s := range sections {
+		if got, want := len(s.rows), len(keyScopes[s.name].actions); notEqual(got, want) {
+			t.Errorf("scope: %s, rows got: %d, want: %d", s.name, got, want)
+		}
+	}
+
+	tests := []struct {
+		keymap map[string]map[string][]string
+		action string
+		keys   string
+	}{
+		{
+			keymap: nil,
+			action: "repositories.open",
+			keys:   "x",
+		},
+		{
+			keymap: nil,
+			action: "repositories.back",
+			keys:   "backspace, ctrl+h",
+		},
+		{
+			keymap: map[string]map[string][]string{"repositories": {"open": {"o", " "}}},
+			action: "repositories.open",
+			keys:   "o, space",
+		},
+	}
+	defer func(saved map[string]map[string][]string) { keymap = saved }(keymap)
+	for _, test := range tests {
+		keymap = test.keymap
+		row, ok := findKeybindingsRow(keybindingsSections(), test.action)
+		if !ok {
+			t.Errorf("action: %s, not found", test.action)
+			continue
+		}
+		if got := row.keys; notEqual(got, test.keys) {
+			t.Errorf("action: %s, keymap: %v, got: %s, want: %s", test.action, test.keymap, got, test.keys)
+		}
+	}
+}
+
+func TestFilterKeybindingsSections(t *testing.T) {
+	sections := []keybindingsSection{
+		{
+			name:  "repositories",
+			title: "Repositories",
+			rows: []keybindingsRow{
+				{keys: "x", desc: "open in browser", action: "repositories.open"},
+				{keys: "S", desc: "sort", action: "repositories.sort"},
+			},
+		},
+		{
+			name:  "timeline",
+			title: "Timeline",
+			rows: []keybindingsRow{
+				{keys: "enter", desc: "jump", action: "timeline.jump"},
+				{keys: "x", desc: "open in browser", action: "timeline.open"},
+			},
+		},
+	}
+	tests := []struct {
+		search string
+		want   []string
+	}{
+		{
+			search: "",
+			want:   []string{"repositories.open", "repositories.sort", "timeline.jump", "timeline.open"},
+		},
+		{
+			search: "  Browser ",
+			want:   []string{"repositories.open", "timeline.open"},
+		},
+		{
+			search: "enter",
+			want:   []string{"timeline.jump"},
+		},
+		{
+			search: "timeline",
+			want:   []string{"timeline.jump", "timeline.open"},
+		},
+		{
+			search: "sort",
+			want:   []string{"repositories.sort"},
+		},
+		{
+			search: "foo",
+			want:   []string{},
+		},
+	}
+	for _, test := range tests {
+		got := make([]string, 0)
+		for _, s := range filterKeybindingsSections(sections, test.search) {
+			for _, r := range s.rows {
+				got = append(got, r.action)
+			}
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("search: %q, got: %v, want: %v", test.search, got, test.want)
+		}
+	}
+}
+
+func TestKeybindingsSearch(t *testing.T) {
+	m := newKeybindingsModel()
+	m.SetSize(120, 40)
+	for _, k := range []string{"/", "b", "r", "o", "w", "s", "e", "r"} {
+		m, _ = m.Update(testKeyMsg(k))
+	}
+	if !m.capturingInput() {
+		t.Fatalf("the search is not being edited")
+	}
+	content := m.contentView()
+	if !strings.Contains(content, "repositories.open") || strings.Contains(content, "repositories.sort") {
+		t.Errorf("the content is not searched: %q", content)
+	}
+
+	m, _ = m.Update(testKeyMsg("esc"))
+	if m.capturingInput() {
+		t.Errorf("the search is not cancelled")
+	}
+	if content := m.contentView(); !strings.Contains(content, "repositories.sort") {
+		t.Errorf("the search is not cleared: %q", content)
+	}
+}
@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+)
+
+const (
+	keymapFileName = "keymap.json"
+
+	// the actions of this name are remapped in all scopes
+	keymapGlobalScope = "global"
+	// the keys of the application are active on every page
+	appKeyScopeName = "app"
+)
+
+// keyAction is an action bound to keys, which can be remapped in the keymap file by its name.
+type keyAction struct {
+	name string
+	desc string
+	keys []string
+	help string // the keys shown in the help while the action is not remapped
+	// the action whose keys are shown together in the help, such as "J/K"
+	pair string
+}
+
+func newKeyAction(name, help, desc string, keys ...string) *keyAction {
+	return &keyAction{
+		name: name,
+		desc: desc,
+		keys: keys,
+		help: help,
+	}
+}
+
+// pairedWith shows the keys of the action together with the other action in the help.
+func (a *keyAction) pairedWith(name string) *keyAction {
+	a.pair = name
+	return a
+}
+
+// keyScope is the actions of a page or a component, which are remapped in the section of its name in the keymap file.
+type keyScope struct {
+	name    string
+	actions []*keyAction
+	// the other scopes whose keys are active together, so they must not conflict
+	others []string
+}
+
+// keyScopes are all the scopes, registered when the package is initialized so that the keymap file can be validated.
+var keyScopes = make(map[string]*keyScope)
+
+func registerKeyScope(name string, actions ...*keyAction) *keyScope {
+	s := &keyScope{
+		name:    name,
+		actions: actions,
+	}
+	keyScopes[name] = s
+	return s
+}
+
+// add appends the actions to the scope.
+func (s *keyScope) add(actions ...*keyAction) *keyScope {
+	s.actions = append(s.actions, actions...)
+	return s
+}
+
+// with declares that the keys of the scopes are active together.
+func (s *keyScope) with(others ...string) *keyScope {
+	s.others = append(s.others, others...)
+	return s
+}
+
+func (s *keyScope) action(name string) *keyAction {
+	for _, a := range s.actions {
+		if a.name == name {
+			return a
+		}
+	}
+	panic(fmt.Sprintf("unknown key action: %s.%s", s.name, name))
+}
+
+// keys returns the keys of the action, remapped by the keymap file, and whether they are remapped.
+func (s *keyScope) keys(name string) ([]string, bool) {
+	if keys, ok := keymap[s.name][name]; ok {
+		return keys, true
+	}
+	return s.action(name).keys, false
+}
+
+// binding returns the binding of the action with the keys remapped by the keymap file.
+func (s *keyScope) binding(name string) key.Binding {
+	a := s.action(name)
+	keys, remapped := s.keys(name)
+	help := a.help
+	if a.pair != "" {
+		pairKeys, pairRemapped := s.keys(a.pair)
+		if remapped || pairRemapped {
+			help = keyHelp(keys) + "/" + keyHelp(pairKeys)
+		}
+	} else if remapped {
+		help = keyHelp(keys)
+	}
+	return key.NewBinding(
+		key.WithKeys(keys...),
+		key.WithHelp(help, a.desc),
+	)
+}
+
+// bindingWithKeys returns the binding of the action with the additional keys, which are shown in the help instead.
+// The dialogs are closed by the keys which opened them, as well as by the keys of the action.
+func (s *keyScope) bindingWithKeys(name string, keys []string) key.Binding {
+	b := s.binding(name)
+	if len(keys) == 0 {
+		return b
+	}
+	return key.NewBinding(
+		key.WithKeys(append(append([]string{}, keys...), b.Keys()...)...),
+		key.WithHelp(keyHelp(keys), b.Help().Desc),
+	)
+}
+
+var keyHelpNames = map[string]string{
+	" ":     "space",
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+}
+
+func keyHelp(keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+	if name, ok := keyHelpNames[keys[0]]; ok {
+		return name
+	}
+	return keys[0]
+}
+
+var keyTypes map[string]tea.KeyType
+
+// keyMsgOf returns the message of pressing the key, the reverse of tea.KeyMsg.String.
+func keyMsgOf(k string) (tea.KeyMsg, bool) {
+	if keyTypes == nil {
+		keyTypes = make(map[string]tea.KeyType)
+		// the named keys are the control characters and the negative types
+		for t := tea.KeyType(-128); t < 128; t++ {
+			if name := t.String(); name != "" && t != tea.KeyRunes {
+				keyTypes[name] = t
+			}
+		}
+	}
+	if t, ok := keyTypes[k]; ok {
+		msg := tea.KeyMsg{Type: t}
+		if t == tea.KeySpace {
+			msg.Runes = []rune{' '}
+		}
+		return msg, true
+	}
+	if rest, ok := strings.CutPrefix(k, "alt+"); ok {
+		msg, ok := keyMsgOf(rest)
+		msg.Alt = true
+		return msg, ok
+	}
+	if utf8.RuneCountInString(k) != 1 {
+		return tea.KeyMsg{}, false
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}, true
+}
+
+// keyPress returns the message of pressing the key of the binding, to run its action by the mouse.
+func keyPress(b key.Binding) tea.KeyMsg {
+	msg, _ := keyMsgOf(b.Keys()[0])
+	return msg
+}
+
+// keymap is the keys remapped by the keymap file, by the scopes and the actions.
+var keymap map[string]map[string][]string
+
+// keymapFile maps the actions to the keys by the scopes, and the global section maps the actions of the name in all scopes.
+type keymapFile map[string]map[string][]string
+
+// loadKeymap reads the keymap file and validates it. It must be called before the key maps of the pages are built.
+func loadKeymap() error {
+	var f keymapFile
+	if err := ghcv.LoadConfigJSON(keymapFileName, &f); err != nil {
+		return err
+	}
+	m, err := resolveKeymap(f)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", keymapFileName, err)
+	}
+	keymap = m
+	return nil
+}
+
+// resolveKeymap applies the global section to the scopes, and checks that every action exists and that no keys conflict.
+func resolveKeymap(f keymapFile) (map[string]map[string][]string, error) {
+	m := make(map[string]map[string][]string)
+	set := func(scope, action string, keys []string) error {
+		if len(keys) == 0 {
+			return fmt.Errorf("no keys for %s.%s", scope, action)
+		}
+		if m[scope] == nil {
+			m[scope] = make(map[string][]string)
+		}
+		m[scope][action] = normalizeKeys(keys)
+		return nil
+	}
+
+	for _, action := range sortedKeys(f[keymapGlobalScope]) {
+		found := false
+		for _, name := range sortedKeys(keyScopes) {
+			if !keyScopes[name].has(action) {
+				continue
+			}
+			found = true
+			if err := set(name, action, f[keymapGlobalScope][action]); err != nil {
+				return nil, err
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown action: %s.%s", keymapGlobalScope, action)
+		}
+	}
+	// the scopes take precedence over the global section
+	for _, name := range sortedKeys(f) {
+		if name == keymapGlobalScope {
+			continue
+		}
+		s, ok := keyScopes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown section: %s", name)
+		}
+		for _, action := range sortedKeys(f[name]) {
+			if !s.has(action) {
+				return nil, fmt.Errorf("unknown action: %s.%s", name, action)
+			}
+			if err := set(name, action, f[name][action]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := checkKeyConflicts(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *keyScope) has(action string) bool {
+	for _, a := range s.actions {
+		if a.name == action {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeKeys accepts "space" for the space key, as the help shows it.
+func normalizeKeys(keys []string) []string {
+	ret := make([]string, len(keys))
+	for i, k := range keys {
+		if k == "space" {
+			k = " "
+		}
+		ret[i] = k
+	}
+	return ret
+}
+
+func checkKeyConflicts(m map[string]map[string][]string) error {
+	for _, name := range sortedKeys(keyScopes) {
+		scopes := append([]string{name}, keyScopes[name].others...)
+		if name != appKeyScopeName {
+			scopes = append(scopes, appKeyScopeName)
+		}
+		bound := make(map[string]string)
+		for _, sn := range scopes {
+			s := keyScopes[sn]
+			for _, a := range s.actions {
+				keys := a.keys
+				if ks, ok := m[sn][a.name]; ok {
+					keys = ks
+				}
+				id := sn + "." + a.name
+				for _, k := range keys {
+					if other, ok := bound[k]; ok && other != id {
+						return fmt.Errorf("key %q is bound to both %s and %s", k, other, id)
+					}
+					bound[k] = id
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var listKeys = registerKeyScope("list",
+	newKeyAction("up", "↑/k", "up", "up", "k"),
+	newKeyAction("down", "↓/j", "down", "down", "j"),
+	newKeyAction("prev-page", "←/h/pgup", "prev page", "left", "h", "pgup", "b", "u"),
+	newKeyAction("next-page", "→/l/pgdn", "next page", "right", "l", "pgdown", "f", "d"),
+	newKeyAction("start", "g/home", "go to start", "home", "g"),
+	newKeyAction("end", "G/end", "go to end", "end", "G"),
+	newKeyAction("filter", "/", "filter", "/"),
+	newKeyAction("help", "?", "more", "?"),
+)
+
+// setupListKeys remaps the keys of the list, except for the keys while filtering.
+func setupListKeys(l *list.Model) {
+	l.KeyMap.CursorUp = listKeys.binding("up")
+	l.KeyMap.CursorDown = listKeys.binding("down")
+	l.KeyMap.PrevPage = listKeys.binding("prev-page")
+	l.KeyMap.NextPage = listKeys.binding("next-page")
+	l.KeyMap.GoToStart = listKeys.binding("start")
+	l.KeyMap.GoToEnd = listKeys.binding("end")
+	l.KeyMap.Filter = listKeys.binding("filter")
+	l.KeyMap.ShowFullHelp = listKeys.binding("help")
+	help := listKeys.binding("help")
+	l.KeyMap.CloseFullHelp = key.NewBinding(
+		key.WithKeys(help.Keys()...),
+		key.WithHelp(help.Help().Key, "close help"),
+	)
+}
+
+// listKeyBindings returns the keys of the list without closing the full help, for the pages which list their keys.
+func listKeyBindings(l list.Model) [][]key.Binding {
+	closeHelp := l.KeyMap.CloseFullHelp.Help()
+	groups := l.FullHelp()
+	ret := make([][]key.Binding, 0, len(groups))
+	for _, g := range groups {
+		bs := make([]key.Binding, 0, len(g))
+		for _, b := range g {
+			if b.Help() != closeHelp {
+				bs = append(bs, b)
+			}
+		}
+		ret = append(ret, bs)
+	}
+	return ret
+}
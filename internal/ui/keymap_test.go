@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"testing"
+)
+
+func TestResolveKeymap(t *testing.T) {
+	tests := []struct {
+		name string
+		file keymapFile
+		want map[string]map[string][]string
+	}{
+		{
+			name: "empty",
+			file: keymapFile{},
+			want: map[string]map[string][]string{},
+		},
+		{
+			name: "global",
+			file: keymapFile{
+				"global": {
+					"down": {"down", "ctrl+n"},
+					"up":   {"up", "ctrl+e"},
+				},
+			},
+			want: map[string]map[string][]string{
+				"follows": {"down": {"down", "ctrl+n"}, "up": {"up", "ctrl+e"}},
+				"list":    {"down": {"down", "ctrl+n"}, "up": {"up", "ctrl+e"}},
+			},
+		},
+		{
+			name: "scope over global",
+			file: keymapFile{
+				"global":       {"back": {"backspace", "ctrl+b"}},
+				"repositories": {"back": {"backspace", "q"}},
+			},
+			want: map[string]map[string][]string{
+				"menu":         {"back": {"backspace", "ctrl+b"}},
+				"repositories": {"back": {"backspace", "q"}},
+			},
+		},
+		{
+			name: "readme",
+			file: keymapFile{
+				"global": {
+					"down": {"down", "ctrl+n"},
+					"up":   {"up", "ctrl+e"},
+					"back": {"backspace", "ctrl+b"},
+				},
+				"repositories": {"open": {"o"}},
+			},
+			want: map[string]map[string][]string{
+				"repositories": {"back": {"backspace", "ctrl+b"}, "open": {"o"}},
+			},
+		},
+		{
+			name: "space",
+			file: keymapFile{
+				"repositories": {"open": {"space"}},
+			},
+			want: map[string]map[string][]string{
+				"repositories": {"open": {" "}},
+			},
+		},
+	}
+	for _, test := range tests {
+		got, err := resolveKeymap(test.file)
+		if err != nil {
+			t.Fatalf("name: %s, unexpected error: %v", test.name, err)
+		}
+		for scope, actions := range test.want {
+			for action, keys := range actions {
+				if notEqual(got[scope][action], keys) {
+					t.Errorf("name: %s, %s.%s got: %v, want: %v", test.name, scope, action, got[scope][action], keys)
+				}
+			}
+		}
+		if test.name == "empty" && notEqual(len(got), 0) {
+			t.Errorf("name: %s, got: %v, want empty", test.name, got)
+		}
+	}
+}
+
+func TestResolveKeymapError(t *testing.T) {
+	tests := []struct {
+		file keymapFile
+		want string
+	}{
+		{
+			file: keymapFile{"global": {"foo": {"f"}}},
+			want: "unknown action: global.foo",
+		},
+		{
+			file: keymapFile{"foo": {"open": {"o"}}},
+			want: "unknown section: foo",
+		},
+		{
+			file: keymapFile{"repositories": {"foo": {"f"}}},
+			want: "unknown action: repositories.foo",
+		},
+		{
+			file: keymapFile{"repositories": {"open": {}}},
+			want: "no keys for repositories.open",
+		},
+		{
+			file: keymapFile{"app": {"yank": {"ctrl+p"}}},
+			want: `key "ctrl+p" is bound to both app.palette and app.yank`,
+		},
+		{
+			file: keymapFile{"repositories": {"open": {"t"}}},
+			want: `key "t" is bound to both repositories.open and table.toggle`,
+		},
+	}
+	for _, test := range tests {
+		_, err := resolveKeymap(test.file)
+		if err == nil {
+			t.Errorf("file: %v, want error: %s", test.file, test.want)
+			continue
+		}
+		if got := err.Error(); notEqual(got, test.want) {
+			t.Errorf("file: %v, got: %s, want: %s", test.file, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package ui
+
+// listCursor is the cursor of a list drawn line by line, and the first row shown,
+// which follows the cursor so that the selected item stays on the screen.
+type listCursor struct {
+	cursor int // index of the items
+	offset int // index of the rows
+}
+
+func (c *listCursor) reset() {
+	c.cursor = 0
+	c.offset = 0
+}
+
+// move moves the cursor among the n items, stopping at the ends, or wrapping around them if wrap is set.
+func (c *listCursor) move(delta, n int, wrap bool) {
+	if n == 0 {
+		return
+	}
+	if wrap {
+		c.cursor = ((c.cursor+delta)%n + n) % n
+	} else {
+		c.cursor = max(min(c.cursor+delta, n-1), 0)
+	}
+}
+
+// scroll shows the rows from first to last of the total rows in the height, moving the offset as little as possible.
+// If they do not fit, the last row is shown.
+func (c *listCursor) scroll(first, last, height, total int) {
+	if first < c.offset {
+		c.offset = first
+	}
+	if last >= c.offset+height {
+		c.offset = last - height + 1
+	}
+	c.offset = max(min(c.offset, total-height), 0)
+}
+
+// scrollToCursor shows the item of the cursor, for the lists which show an item per row.
+func (c *listCursor) scrollToCursor(height, total int) {
+	c.scroll(c.cursor, c.cursor, height, total)
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"testing"
+)
+
+func TestListCursorMove(t *testing.T) {
+	tests := []struct {
+		cursor int
+		delta  int
+		n      int
+		wrap   bool
+		want   int
+	}{
+		{cursor: 0, delta: 1, n: 3, wrap: false, want: 1},
+		{cursor: 2, delta: 1, n: 3, wrap: false, want: 2},
+		{cursor: 0, delta: -1, n: 3, wrap: false, want: 0},
+		{cursor: 1, delta: 10, n: 3, wrap: false, want: 2},
+		{cursor: 2, delta: 1, n: 3, wrap: true, want: 0},
+		{cursor: 0, delta: -1, n: 3, wrap: true, want: 2},
+		{cursor: 1, delta: -4, n: 3, wrap: true, want: 0},
+		{cursor: 0, delta: 1, n: 0, wrap: true, want: 0},
+	}
+	for _, test := range tests {
+		c := listCursor{cursor: test.cursor}
+		c.move(test.delta, test.n, test.wrap)
+		if got := c.cursor; notEqual(got, test.want) {
+			t.Errorf("cursor: %d, delta: %d, n: %d, wrap: %v, got: %d, want: %d", test.cursor, test.delta, test.n, test.wrap, got, test.want)
+		}
+	}
+}
+
+func TestListCursorScroll(t *testing.T) {
+	tests := []struct {
+		offset      int
+		first, last int
+		height      int
+		total       int
+		want        int
+	}{
+		// shown already
+		{offset: 2, first: 3, last: 3, height: 5, total: 20, want: 2},
+		// above
+		{offset: 5, first: 3, last: 3, height: 5, total: 20, want: 3},
+		// below
+		{offset: 0, first: 7, last: 7, height: 5, total: 20, want: 3},
+		// the row above the first is shown with it
+		{offset: 5, first: 4, last: 5, height: 5, total: 20, want: 4},
+		// the last is shown if both do not fit
+		{offset: 0, first: 4, last: 5, height: 1, total: 20, want: 5},
+		// no empty rows at the end, when the height grows
+		{offset: 15, first: 18, last: 18, height: 10, total: 20, want: 10},
+		{offset: 3, first: 3, last: 3, height: 10, total: 5, want: 0},
+	}
+	for _, test := range tests {
+		c := listCursor{offset: test.offset}
+		c.scroll(test.first, test.last, test.height, test.total)
+		if got := c.offset; notEqual(got, test.want) {
+			t.Errorf("offset: %d, rows: %d-%d, height: %d, total: %d, got: %d, want: %d", test.offset, test.first, test.last, test.height, test.total, got, test.want)
+		}
+	}
+}
@@ -9,21 +9,29 @@ import (
 const (
 	menuTitleProfile      = "Profile"
 	menuTitlePullRequests = "Pull Requests"
+	menuTitlePRStats      = "PR Stats"
 	menuTitleRepositories = "Repositories"
+	menuTitleRepoStats    = "Repo Stats"
+	menuTitleTimeline     = "Timeline"
+	menuTitleFollowers    = "Followers"
+	menuTitleFollowing    = "Following"
 	menuTitleHelp         = "Help"
 )
 
 type menuModel struct {
 	list list.Model
 
+	delegate     list.DefaultDelegate
 	delegateKeys menuDelegateKeyMap
 
 	selectedUser  string
+	previousUsers []string
 	width, height int
 }
 
-func newMenuModel() menuModel {
-	items := []list.Item{
+// newMenuModel returns the menu of the user, or of the organization without the pages querying a user.
+func newMenuModel(organization bool) menuModel {
+	allItems := []menuItem{
 		menuItem{
 			title:       menuTitleProfile,
 			description: "Show the user's profile",
@@ -31,16 +39,47 @@ func newMenuModel() menuModel {
 		menuItem{
 			title:       menuTitlePullRequests,
 			description: "Show Pull Requests created by the user",
+			userOnly:    true,
+		},
+		menuItem{
+			title:       menuTitlePRStats,
+			description: "Show statistics of Pull Requests created by the user",
+			userOnly:    true,
 		},
 		menuItem{
 			title:       menuTitleRepositories,
 			description: "Show Repositories created by the user",
 		},
+		menuItem{
+			title:       menuTitleRepoStats,
+			description: "Show statistics of Repositories created by the user",
+		},
+		menuItem{
+			title:       menuTitleTimeline,
+			description: "Show the user's activities in chronological order",
+			userOnly:    true,
+		},
+		menuItem{
+			title:       menuTitleFollowers,
+			description: "Show users following the user",
+			userOnly:    true,
+		},
+		menuItem{
+			title:       menuTitleFollowing,
+			description: "Show users followed by the user",
+			userOnly:    true,
+		},
 		menuItem{
 			title:       menuTitleHelp,
 			description: "Show help menus",
 		},
 	}
+	items := make([]list.Item, 0, len(allItems))
+	for _, item := range allItems {
+		if !organization || !item.userOnly {
+			items = append(items, item)
+		}
+	}
 
 	delegate := list.NewDefaultDelegate()
 
@@ -52,26 +91,33 @@ func newMenuModel() menuModel {
 		return [][]key.Binding{{delegateKeys.sel, delegateKeys.back}}
 	}
 
-	// bubbles/list/defaultitem.go
-	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.Copy().Foreground(selectedColor1).BorderForeground(selectedColor2)
-	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.Copy().Foreground(selectedColor2).BorderForeground(selectedColor2)
+	delegate.Styles = newListItemStyles()
 	l := list.New(items, delegate, 0, 0)
-	l.KeyMap.Quit = key.NewBinding(
-		key.WithKeys("ctrl+c", "esc"),
-		key.WithHelp("ctrl+c", "quit"),
-	)
+	l.KeyMap.Quit = menuKeys.binding("quit")
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 	return menuModel{
 		list:         l,
+		delegate:     delegate,
 		delegateKeys: delegateKeys,
 	}
 }
 
+func init() {
+	registerPage(func(ctx *pageContext, msg userSelectMsg) page {
+		m := newMenuModel(msg.organization)
+		m.SetUser(msg.id)
+		m.SetPreviousUsers(ctx.previousUsers)
+		return newRoutedPage(m)
+	})
+}
+
 type menuItem struct {
 	title       string
 	description string
+	userOnly    bool // the page queries a user, so it is not available for an organization
 }
 
 var _ list.DefaultItem = (*menuItem)(nil)
@@ -93,16 +139,16 @@ type menuDelegateKeyMap struct {
 	sel  key.Binding
 }
 
+var menuKeys = registerKeyScope("menu",
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("select", "enter", "select", "enter"),
+).with("list")
+
 func newMenuDelegateKeyMap() menuDelegateKeyMap {
 	return menuDelegateKeyMap{
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		sel: key.NewBinding(
-			key.WithKeys("enter"),
-			key.WithHelp("enter", "select"),
-		),
+		back: menuKeys.binding("back"),
+		sel:  menuKeys.binding("select"),
 	}
 }
 
@@ -116,29 +162,59 @@ func (m *menuModel) SetUser(id string) {
 	m.selectedUser = id
 }
 
+func (m *menuModel) SetPreviousUsers(ids []string) {
+	m.previousUsers = ids
+}
+
 func (m menuModel) Init() tea.Cmd {
 	return nil
 }
 
+func (m menuModel) capturingInput() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
 func (m menuModel) Update(msg tea.Msg) (menuModel, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0)
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
 		switch {
 		case key.Matches(msg, m.delegateKeys.sel):
-			switch m.list.SelectedItem().(menuItem).Title() {
+			item, ok := m.list.SelectedItem().(menuItem)
+			if !ok {
+				return m, nil
+			}
+			switch item.Title() {
 			case menuTitleProfile:
 				return m, selectProfilePage(m.selectedUser)
 			case menuTitleRepositories:
 				return m, selectRepositoriesPage(m.selectedUser)
+			case menuTitleRepoStats:
+				return m, selectRepositoryStatsPage(m.selectedUser)
 			case menuTitlePullRequests:
 				return m, selectPullRequestsPage(m.selectedUser)
+			case menuTitlePRStats:
+				return m, selectPullRequestStatsPage(m.selectedUser)
+			case menuTitleTimeline:
+				return m, selectTimelinePage(m.selectedUser)
+			case menuTitleFollowers:
+				return m, selectFollowsPage(m.selectedUser, followersKind)
+			case menuTitleFollowing:
+				return m, selectFollowsPage(m.selectedUser, followingKind)
 			case menuTitleHelp:
 				return m, selectHelpPage
 			}
 		case key.Matches(msg, m.delegateKeys.back):
-			return m, goBackUserSelectPage
+			return m, goBack
+		}
+	case tea.MouseMsg:
+		if updateListMouse(&m.list, m.delegate, msg, m.itemsTop()) {
+			return m.Update(keyPress(m.delegateKeys.sel))
 		}
+		return m, nil
 	case userSelectMsg:
 		m.list.ResetSelected()
 	}
@@ -150,10 +226,20 @@ func (m menuModel) Update(msg tea.Msg) (menuModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+func (m menuModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
+}
+
+func (m menuModel) itemsTop() int {
+	// below the title
+	return 2 + listItemsOffset(m.list)
+}
+
 func (m menuModel) View() string {
 	return titleView(m.breadcrumb()) + listView(m.list)
 }
 
 func (m menuModel) breadcrumb() []string {
-	return []string{m.selectedUser}
+	// the users visited before are shown to tell where going back returns to
+	return append(append([]string{}, m.previousUsers...), m.selectedUser)
 }
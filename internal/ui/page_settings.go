@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+)
+
+const (
+	pageSettingsFileName = "pages.json"
+)
+
+// pageSettingsFile is the settings chosen on the pages, which are restored when the application is started again.
+type pageSettingsFile struct {
+	Sort   map[string]*savedSortOrder   `json:"sort,omitempty"`
+	Tables map[string]*savedTableLayout `json:"tables,omitempty"`
+}
+
+// pageSettings is loaded when it is first used, and stored by the application after a page changes it.
+var pageSettings struct {
+	file    *pageSettingsFile
+	loadErr error
+	changed bool
+}
+
+func loadPageSettings() (*pageSettingsFile, error) {
+	var f pageSettingsFile
+	if err := ghcv.LoadConfigJSON(pageSettingsFileName, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// currentPageSettings returns the settings, which are empty if the file cannot be loaded.
+func currentPageSettings() *pageSettingsFile {
+	if pageSettings.file == nil {
+		pageSettings.file, pageSettings.loadErr = loadPageSettings()
+		if pageSettings.loadErr != nil {
+			pageSettings.file = &pageSettingsFile{}
+		}
+	}
+	if pageSettings.file.Sort == nil {
+		pageSettings.file.Sort = make(map[string]*savedSortOrder)
+	}
+	if pageSettings.file.Tables == nil {
+		pageSettings.file.Tables = make(map[string]*savedTableLayout)
+	}
+	return pageSettings.file
+}
+
+func changePageSettings() {
+	pageSettings.changed = true
+}
+
+// storePageSettings writes the settings if they have changed.
+// If the file could not be loaded, it is not overwritten, not to lose the settings in it, and the load error is returned.
+func storePageSettings() error {
+	if !pageSettings.changed {
+		return nil
+	}
+	pageSettings.changed = false
+	if pageSettings.loadErr != nil {
+		return pageSettings.loadErr
+	}
+	return ghcv.StoreConfigJSON(pageSettingsFileName, pageSettings.file)
+}
@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTableLayoutRestore(t *testing.T) {
+	columns := func() []*tableColumn {
+		return []*tableColumn{{title: "Name", width: 10}, {title: "Description", flex: true}}
+	}
+	tests := []struct {
+		saved       *savedTableLayout
+		wantEnabled bool
+		wantWidths  []int
+	}{
+		{
+			saved:       nil,
+			wantEnabled: false,
+			wantWidths:  []int{10, 0},
+		},
+		{
+			saved:       &savedTableLayout{Enabled: true, Widths: map[string]int{"Name": 20}},
+			wantEnabled: true,
+			wantWidths:  []int{20, 0},
+		},
+		{
+			// too narrow widths, the widths of flexible or unknown columns are ignored
+			saved:       &savedTableLayout{Enabled: true, Widths: map[string]int{"Name": 1, "Description": 30, "Stars": 5}},
+			wantEnabled: true,
+			wantWidths:  []int{10, 0},
+		},
+	}
+	for _, test := range tests {
+		pageSettings.file = &pageSettingsFile{Tables: map[string]*savedTableLayout{}}
+		if test.saved != nil {
+			pageSettings.file.Tables["test"] = test.saved
+		}
+		tb := newTable("test", columns(), nil)
+		if got := tb.enabled; notEqual(got, test.wantEnabled) {
+			t.Errorf("saved: %+v, enabled got: %v, want: %v", test.saved, got, test.wantEnabled)
+		}
+		if got := []int{tb.columns[0].width, tb.columns[1].width}; notEqual(got, test.wantWidths) {
+			t.Errorf("saved: %+v, widths got: %v, want: %v", test.saved, got, test.wantWidths)
+		}
+	}
+
+	// the layout changed on the table is restored by the next table of the page
+	pageSettings.file = &pageSettingsFile{}
+	keys := newTableKeyMap()
+	tb := newTable("test", columns(), nil)
+	tb.updateKey(keyPress(keys.toggle), keys)
+	tb.updateKey(keyPress(keys.wider), keys)
+	tb = newTable("test", columns(), nil)
+	if got := tb.enabled; !got {
+		t.Errorf("enabled got: %v, want: true", got)
+	}
+	if got, want := tb.columns[0].width, 12; notEqual(got, want) {
+		t.Errorf("width got: %d, want: %d", got, want)
+	}
+	pageSettings.file = nil
+	pageSettings.changed = false
+}
+
+func TestStorePageSettingsLoadError(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	loadErr := errors.New("invalid pages.json")
+	pageSettings.file = &pageSettingsFile{}
+	pageSettings.loadErr = loadErr
+	pageSettings.changed = true
+	// not to overwrite the settings in the file which could not be loaded
+	if err := storePageSettings(); err != loadErr {
+		t.Errorf("got: %v, want: %v", err, loadErr)
+	}
+	pageSettings.file = nil
+	pageSettings.loadErr = nil
+}
+
+func TestSortOrderRestore(t *testing.T) {
+	fields := []*sortField{{name: "a"}, {name: "b", defaultDesc: true}, {name: "c"}}
+	defaultOrder := sortOrder{primary: 0, secondary: noSortField}
+	tests := []struct {
+		saved *savedSortOrder
+		want  sortOrder
+	}{
+		{
+			saved: nil,
+			want:  sortOrder{primary: 0, primaryDesc: false, secondary: noSortField},
+		},
+		{
+			saved: &savedSortOrder{Primary: "b", PrimaryDesc: false, Secondary: "c", SecondaryDesc: true},
+			want:  sortOrder{primary: 1, primaryDesc: false, secondary: 2, secondaryDesc: true},
+		},
+		{
+			// unknown fields are ignored
+			saved: &savedSortOrder{Primary: "x", Secondary: "c"},
+			want:  sortOrder{primary: 0, primaryDesc: false, secondary: 2, secondaryDesc: false},
+		},
+		{
+			saved: &savedSortOrder{Primary: "b", Secondary: "b"},
+			want:  sortOrder{primary: 1, primaryDesc: false, secondary: noSortField},
+		},
+	}
+	for _, test := range tests {
+		pageSettings.file = &pageSettingsFile{Sort: map[string]*savedSortOrder{"test": test.saved}}
+		if got := newItemSorter("test", fields, defaultOrder).sortOrder; notEqual(got, test.want) {
+			t.Errorf("saved: %+v, got: %+v, want: %+v", test.saved, got, test.want)
+		}
+	}
+
+	// the order chosen on the table is restored by the next sorter of the page
+	pageSettings.file = &pageSettingsFile{}
+	columns := []*tableColumn{{title: "A", sortBy: "a"}, {title: "B", sortBy: "b"}, {title: "C"}}
+	sorter := newItemSorter("test", fields, defaultOrder)
+	newTable("test", columns, sorter).sortBy(1)
+	sorter.cycleSecondary(2)
+	want := sortOrder{primary: 1, primaryDesc: true, secondary: 2, secondaryDesc: false}
+	if got := newItemSorter("test", fields, defaultOrder).sortOrder; notEqual(got, want) {
+		t.Errorf("got: %+v, want: %+v", got, want)
+	}
+	if !pageSettings.changed {
+		t.Errorf("want the settings changed")
+	}
+	pageSettings.file = nil
+	pageSettings.changed = false
+}
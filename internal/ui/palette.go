@@ -0,0 +1,308 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/lusingander/kasane"
+	"github.com/muesli/reflow/truncate"
+	"github.com/sahilm/fuzzy"
+)
+
+var (
+	paletteStyle = lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder())
+
+	paletteBodyStyle = lipgloss.NewStyle().
+				Padding(0, 2)
+
+	paletteSelectedStyle = lipgloss.NewStyle().
+				Foreground(theme.Selected.Color())
+
+	paletteKeyStyle = lipgloss.NewStyle().
+			Foreground(theme.Muted.Color())
+
+	paletteMatchStyle = lipgloss.NewStyle().
+				Underline(true)
+)
+
+const (
+	paletteWidth   = 60
+	paletteMaxRows = 12
+	// the borders, the title and the input of the palette, and some space around it
+	paletteFrameHeight = 8
+)
+
+var paletteKeys = registerKeyScope("palette",
+	newKeyAction("select-next", "↓", "select next", "down", "ctrl+j"),
+	newKeyAction("select-prev", "↑", "select prev", "up", "ctrl+k"),
+	newKeyAction("run", "enter", "run", "enter"),
+	newKeyAction("close", "esc", "close palette", "esc"),
+)
+
+type paletteKeyMap struct {
+	next  key.Binding
+	prev  key.Binding
+	run   key.Binding
+	close key.Binding
+}
+
+// newPaletteKeyMap builds the keys of the palette, which is also closed by the keys which opened it.
+func newPaletteKeyMap(closeKeys []string) paletteKeyMap {
+	return paletteKeyMap{
+		next:  paletteKeys.binding("select-next"),
+		prev:  paletteKeys.binding("select-prev"),
+		run:   paletteKeys.binding("run"),
+		close: paletteKeys.bindingWithKeys("close", closeKeys),
+	}
+}
+
+// paletteCommand is an action listed in the palette, which sends the message when it is run.
+type paletteCommand struct {
+	name string
+	key  string // the key bound to the action, shown next to the name
+	msg  tea.Msg
+}
+
+// bindingCommand returns the command which presses the key of the binding, or false if the binding is not available.
+func bindingCommand(b key.Binding) (paletteCommand, bool) {
+	if !b.Enabled() || b.Help().Desc == "" {
+		return paletteCommand{}, false
+	}
+	msg, ok := keyMsgOf(b.Keys()[0])
+	if !ok {
+		return paletteCommand{}, false
+	}
+	return paletteCommand{
+		name: b.Help().Desc,
+		key:  b.Help().Key,
+		msg:  msg,
+	}, true
+}
+
+// commandPalette is an overlay to find the actions available on the page by typing,
+// so that the keys of each page do not have to be memorized.
+type commandPalette struct {
+	opened   bool
+	keys     paletteKeyMap
+	input    textinput.Model
+	commands []paletteCommand
+	// the commands which match the input, the best first
+	matches []fuzzy.Match
+	listCursor
+
+	width, height int
+}
+
+func newCommandPalette(closeKeys []string) *commandPalette {
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "Type to search actions"
+	// the palette does not receive the blink messages, which are passed to the page
+	input.Cursor.SetMode(cursor.CursorStatic)
+	return &commandPalette{
+		keys:  newPaletteKeyMap(closeKeys),
+		input: input,
+	}
+}
+
+func (p *commandPalette) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	p.input.Width = p.dialogWidth() - paletteBodyStyle.GetHorizontalPadding() - lipgloss.Width(p.input.Prompt) - 1
+	p.scroll()
+}
+
+func (p *commandPalette) open(commands []paletteCommand) tea.Cmd {
+	p.opened = true
+	p.commands = commands
+	p.input.Reset()
+	p.reset()
+	p.updateMatches()
+	return p.input.Focus()
+}
+
+func (p *commandPalette) close() {
+	p.opened = false
+	p.input.Blur()
+}
+
+func (p *commandPalette) updateMatches() {
+	pattern := strings.TrimSpace(p.input.Value())
+	if pattern == "" {
+		p.matches = make([]fuzzy.Match, len(p.commands))
+		for i, c := range p.commands {
+			p.matches[i] = fuzzy.Match{Str: c.name, Index: i}
+		}
+	} else {
+		names := make([]string, len(p.commands))
+		for i, c := range p.commands {
+			names[i] = c.name
+		}
+		p.matches = fuzzy.Find(pattern, names)
+	}
+	p.cursor = 0
+	p.scroll()
+}
+
+func (p *commandPalette) rows() int {
+	return max(min(len(p.matches), paletteMaxRows, p.height-paletteFrameHeight), 1)
+}
+
+// scroll keeps the cursor inside the displayed rows.
+func (p *commandPalette) scroll() {
+	p.scrollToCursor(p.rows(), len(p.matches))
+}
+
+func (p *commandPalette) move(delta int) {
+	p.listCursor.move(delta, len(p.matches), true)
+	p.scroll()
+}
+
+// run closes the palette and returns the command to run the selected action.
+func (p *commandPalette) run() tea.Cmd {
+	if p.cursor >= len(p.matches) {
+		return nil
+	}
+	c := p.commands[p.matches[p.cursor].Index]
+	p.close()
+	return func() tea.Msg {
+		return c.msg
+	}
+}
+
+func (p *commandPalette) update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, p.keys.close):
+			p.close()
+			return nil
+		case key.Matches(msg, p.keys.next):
+			p.move(1)
+			return nil
+		case key.Matches(msg, p.keys.prev):
+			p.move(-1)
+			return nil
+		case key.Matches(msg, p.keys.run):
+			return p.run()
+		}
+		value := p.input.Value()
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(msg)
+		if p.input.Value() != value {
+			p.updateMatches()
+		}
+		return cmd
+	case tea.MouseMsg:
+		return p.updateMouse(msg)
+	}
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return cmd
+}
+
+func (p *commandPalette) updateMouse(msg tea.MouseMsg) tea.Cmd {
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		p.move(1)
+		return nil
+	case tea.MouseButtonWheelUp:
+		p.move(-1)
+		return nil
+	}
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+		return nil
+	}
+	top, left, w, h := p.bounds()
+	if msg.X < left || left+w <= msg.X || msg.Y < top || top+h <= msg.Y {
+		p.close()
+		return nil
+	}
+	// the top border, the title (with its bottom border) and the input
+	row := msg.Y - top - 4
+	if row < 0 || p.rows() <= row || len(p.matches) <= p.offset+row {
+		return nil
+	}
+	p.cursor = p.offset + row
+	return p.run()
+}
+
+func (p *commandPalette) dialogWidth() int {
+	return max(min(paletteWidth, p.width-4), selectDialogMinWidth)
+}
+
+func (p *commandPalette) dialogView() string {
+	width := p.dialogWidth()
+	bodyWidth := width - paletteBodyStyle.GetHorizontalPadding()
+
+	lines := []string{p.input.View()}
+	end := min(p.offset+p.rows(), len(p.matches))
+	for i := p.offset; i < end; i++ {
+		lines = append(lines, p.itemView(i, bodyWidth))
+	}
+	if len(p.matches) == 0 {
+		lines = append(lines, paletteKeyStyle.Render("  No actions"))
+	}
+	if n := len(p.matches); n > p.rows() {
+		lines = append(lines, paletteKeyStyle.Render(fmt.Sprintf("  %d-%d of %d", p.offset+1, end, n)))
+	}
+
+	title := dialogTitleStyle.Copy().Width(width).Render("Actions")
+	body := paletteBodyStyle.Copy().Width(width).Render(strings.Join(lines, "\n"))
+	return paletteStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, body))
+}
+
+func (p *commandPalette) itemView(i, width int) string {
+	m := p.matches[i]
+	c := p.commands[m.Index]
+	keyWidth := lipgloss.Width(c.key)
+	name := truncate.StringWithTail(c.name, uint(max(width-keyWidth-3, 0)), ellipsis)
+
+	style := lipgloss.NewStyle()
+	prefix := "  "
+	if i == p.cursor {
+		style = paletteSelectedStyle
+		prefix = "> "
+	}
+	runes := make([]int, 0, len(m.MatchedIndexes))
+	for _, b := range m.MatchedIndexes {
+		runes = append(runes, utf8.RuneCountInString(c.name[:b]))
+	}
+	name = lipgloss.StyleRunes(name, runes, style.Copy().Inherit(paletteMatchStyle), style)
+
+	gap := max(width-2-lipgloss.Width(name)-keyWidth, 1)
+	return style.Render(prefix) + name + strings.Repeat(" ", gap) + paletteKeyStyle.Render(c.key)
+}
+
+// bounds returns the position and the size of the palette on the screen.
+func (p *commandPalette) bounds() (top, left, width, height int) {
+	width, height = lipgloss.Size(p.dialogView())
+	top = max((p.height/2)-(height/2), 0)
+	left = (p.width / 2) - (width / 2)
+	return
+}
+
+func (p *commandPalette) view(base string) string {
+	top, left, _, _ := p.bounds()
+	return kasane.OverlayString(base, p.dialogView(), top, left, kasane.WithPadding(p.width))
+}
+
+// sectionCommands returns the commands to switch to the sections of the user by their keys.
+func sectionCommands(sections []*userSection, keys []key.Binding) []paletteCommand {
+	commands := make([]paletteCommand, 0, len(sections))
+	for i, s := range sections {
+		if c, ok := bindingCommand(keys[i]); ok {
+			c.name = "go to " + s.title
+			commands = append(commands, c)
+		}
+	}
+	return commands
+}
@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+const (
+	// the preview pane is shown only when the page is at least this wide
+	previewMinWidth = 120
+	// the body is loaded after the selection stays on the item for a while
+	previewLoadDebounce = 300 * time.Millisecond
+)
+
+var (
+	previewStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(theme.Muted.Color()).
+			Padding(0, 1, 0, 2)
+
+	previewTitleStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(theme.Selected.Color())
+
+	previewLabelStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color()).
+				Width(12)
+
+	previewSectionStyle = lipgloss.NewStyle().
+				Bold(true).
+				Foreground(theme.Muted.Color())
+
+	previewMessageStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color())
+
+	previewErrorStyle = lipgloss.NewStyle().
+				Foreground(theme.Error.Color())
+)
+
+type previewKeyMap struct {
+	toggle key.Binding
+	down   key.Binding
+	up     key.Binding
+}
+
+var previewKeys = registerKeyScope("preview",
+	newKeyAction("toggle", "p", "toggle preview", "p"),
+	newKeyAction("scroll-down", "J/K", "scroll preview", "J").pairedWith("scroll-up"),
+	newKeyAction("scroll-up", "K", "scroll preview up", "K"),
+)
+
+func newPreviewKeyMap() previewKeyMap {
+	return previewKeyMap{
+		toggle: previewKeys.binding("toggle"),
+		down:   previewKeys.binding("scroll-down"),
+		up:     previewKeys.binding("scroll-up"),
+	}
+}
+
+type previewField struct {
+	name  string
+	value string
+}
+
+// preview is the contents of the preview pane for an item of the list.
+type preview struct {
+	key         string // identifies the item
+	title       string
+	description string
+	fields      []previewField
+	url         string
+	bodyName    string
+	loadBody    func() (string, error) // nil if the item has no body
+}
+
+// previewPane shows the details of the selected item on the right of the list.
+// The pane is collapsed when the page is narrow.
+type previewPane struct {
+	enabled  bool
+	viewport viewport.Model
+	current  *preview
+	// the loaded bodies by the key of the items
+	bodies map[string]string
+	errors map[string]error
+	seq    int
+
+	width, height int
+}
+
+func newPreviewPane() *previewPane {
+	return &previewPane{
+		enabled:  true,
+		viewport: viewport.New(0, 0),
+		bodies:   make(map[string]string),
+		errors:   make(map[string]error),
+	}
+}
+
+// SetSize sets the size of the list and the pane together.
+func (p *previewPane) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	p.viewport.Width = max(p.paneWidth()-previewStyle.GetHorizontalFrameSize(), 0)
+	// the first line is the top margin of the list
+	p.viewport.Height = max(height-1-previewStyle.GetVerticalFrameSize(), 0)
+	p.updateContent()
+}
+
+func (p *previewPane) visible() bool {
+	return p.enabled && p.width >= previewMinWidth
+}
+
+// listWidth returns the width left for the list.
+func (p *previewPane) listWidth() int {
+	if !p.visible() {
+		return p.width
+	}
+	return p.width * 2 / 5
+}
+
+func (p *previewPane) paneWidth() int {
+	return p.width - p.listWidth()
+}
+
+// updateKey handles the keys of the pane, and reports whether the key is handled.
+func (p *previewPane) updateKey(msg tea.KeyMsg, keys previewKeyMap) bool {
+	switch {
+	case key.Matches(msg, keys.toggle):
+		p.enabled = !p.enabled
+		p.SetSize(p.width, p.height)
+		return true
+	case !p.visible():
+		return false
+	case key.Matches(msg, keys.down):
+		p.viewport.LineDown(3)
+		return true
+	case key.Matches(msg, keys.up):
+		p.viewport.LineUp(3)
+		return true
+	}
+	return false
+}
+
+// updateMouse scrolls the pane by the wheel over it, and reports whether the event is on the pane.
+func (p *previewPane) updateMouse(msg tea.MouseMsg) bool {
+	if !p.visible() || msg.X < p.listWidth() {
+		return false
+	}
+	p.viewport, _ = p.viewport.Update(msg)
+	return true
+}
+
+type previewLoadMsg struct {
+	seq int
+}
+
+var _ tea.Msg = (*previewLoadMsg)(nil)
+
+type previewBodyMsg struct {
+	key  string
+	body string
+	err  error
+}
+
+var _ tea.Msg = (*previewBodyMsg)(nil)
+
+// show shows the item in the pane, and loads its body after a while unless another item is selected in the meantime.
+func (p *previewPane) show(pv *preview) tea.Cmd {
+	if pv == nil {
+		p.current = nil
+		p.updateContent()
+		return nil
+	}
+	if p.current != nil && p.current.key == pv.key {
+		return nil
+	}
+	p.current = pv
+	p.viewport.GotoTop()
+	p.updateContent()
+	if pv.loadBody == nil || p.loaded(pv.key) {
+		return nil
+	}
+	p.seq++
+	seq := p.seq
+	return tea.Tick(previewLoadDebounce, func(time.Time) tea.Msg {
+		return previewLoadMsg{seq}
+	})
+}
+
+func (p *previewPane) loaded(key string) bool {
+	_, ok := p.bodies[key]
+	_, failed := p.errors[key]
+	return ok || failed
+}
+
+func (p *previewPane) update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case previewLoadMsg:
+		if msg.seq != p.seq || p.current == nil {
+			return nil
+		}
+		key, load := p.current.key, p.current.loadBody
+		return func() tea.Msg {
+			body, err := load()
+			return previewBodyMsg{key, body, err}
+		}
+	case previewBodyMsg:
+		if msg.err != nil {
+			p.errors[msg.key] = msg.err
+		} else {
+			p.bodies[msg.key] = msg.body
+		}
+		if p.current != nil && p.current.key == msg.key {
+			p.updateContent()
+		}
+	}
+	return nil
+}
+
+func (p *previewPane) updateContent() {
+	if p.current == nil || p.viewport.Width <= 0 {
+		p.viewport.SetContent("")
+		return
+	}
+	p.viewport.SetContent(p.contentView(p.current, p.viewport.Width))
+}
+
+func (p *previewPane) contentView(pv *preview, width int) string {
+	wrap := lipgloss.NewStyle().Width(width)
+
+	lines := make([]string, 0)
+	lines = append(lines, wrap.Inherit(previewTitleStyle).Render(pv.title))
+	if pv.description != "" {
+		lines = append(lines, wrap.Render(pv.description))
+	}
+	lines = append(lines, "")
+	for _, f := range pv.fields {
+		lines = append(lines, previewLabelStyle.Render(f.name)+f.value)
+	}
+	if pv.url != "" {
+		lines = append(lines, "", urlTextStyle.Render(pv.url))
+	}
+	if pv.loadBody != nil {
+		lines = append(lines, "", previewSectionStyle.Render(pv.bodyName))
+		lines = append(lines, p.bodyView(pv, wrap))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (p *previewPane) bodyView(pv *preview, wrap lipgloss.Style) string {
+	if err, ok := p.errors[pv.key]; ok {
+		return previewErrorStyle.Render("ERROR: " + err.Error())
+	}
+	body, ok := p.bodies[pv.key]
+	if !ok {
+		return previewMessageStyle.Render("Loading...")
+	}
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return previewMessageStyle.Render("No " + strings.ToLower(pv.bodyName))
+	}
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	body = strings.ReplaceAll(body, "\t", "    ")
+	return wrap.Render(body)
+}
+
+func (p *previewPane) view() string {
+	return previewStyle.Render(p.viewport.View())
+}
+
+// splitView places the list and the pane side by side if the pane is visible.
+func (p *previewPane) splitView(list string) string {
+	if !p.visible() {
+		return list
+	}
+	// the top margin of the list follows the title, so it is not joined with the pane
+	_, list, _ = strings.Cut(list, "\n")
+	list = lipgloss.PlaceHorizontal(p.listWidth(), lipgloss.Left, list)
+	return "\n" + lipgloss.JoinHorizontal(lipgloss.Top, list, p.view())
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%s (%s)", t.Format("2006-01-02"), formatDuration(t))
+}
+
+func repositoryPreview(client *gh.GitHubClient, owner string, repo *gh.UserRepository) *preview {
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+	fields := []previewField{
+		{"Language", orDash(repo.LangName)},
+		{"License", orDash(repo.License)},
+		{"Stars", fmt.Sprint(repo.Stars)},
+		{"Forks", fmt.Sprint(repo.Forks)},
+		{"Watchers", fmt.Sprint(repo.Watchers)},
+		{"Issues", fmt.Sprintf("%d open", repo.OpenedIssues)},
+		{"PRs", fmt.Sprintf("%d open", repo.OpenedPullRequests)},
+		{"Created", formatDate(repo.CreatedAt)},
+		{"Pushed", formatDate(repo.PushedAt)},
+	}
+	if repo.Archived {
+		fields = append(fields, previewField{"Archived", "yes"})
+	}
+	return &preview{
+		key:         owner + "/" + repo.Name,
+		title:       repo.Name,
+		description: repo.Description,
+		fields:      fields,
+		url:         repo.Url,
+		bodyName:    "README",
+		loadBody: func() (string, error) {
+			return client.QueryRepositoryReadme(owner, repo.Name)
+		},
+	}
+}
+
+func pullRequestPreview(client *gh.GitHubClient, owner string, repo *gh.UserPullRequestsRepository, pr *gh.UserPullRequestsPullRequest) *preview {
+	fields := []previewField{
+		{"Status", pr.State},
+		{"Repository", fmt.Sprintf("%s/%s (★ %d)", owner, repo.Name, repo.Stars)},
+		{"Number", fmt.Sprintf("#%d", pr.Number)},
+		{"Changes", fmt.Sprintf("+%d -%d", pr.Additions, pr.Deletions)},
+		{"Comments", fmt.Sprint(pr.Comments)},
+		{"Created", formatDate(pr.CretaedAt)},
+	}
+	if !pr.ClosedAt.IsZero() {
+		fields = append(fields, previewField{"Closed", formatDate(pr.ClosedAt)})
+	}
+	return &preview{
+		key:      fmt.Sprintf("%s/%s#%d", owner, repo.Name, pr.Number),
+		title:    pr.Title,
+		fields:   fields,
+		url:      pr.Url,
+		bodyName: "Description",
+		loadBody: func() (string, error) {
+			return client.QueryPullRequestBody(owner, repo.Name, pr.Number)
+		},
+	}
+}
@@ -11,12 +11,13 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 var (
 	profileErrorStyle = lipgloss.NewStyle().
 				Padding(2, 0, 0, 2).
-				Foreground(lipgloss.Color("161"))
+				Foreground(theme.Error.Color())
 
 	profileItemStyle = lipgloss.NewStyle().
 				Padding(1, 0, 1, 2)
@@ -28,10 +29,18 @@ var (
 				Padding(1, 0, 0, 0)
 
 	profileSelectedItemColorStyle = lipgloss.NewStyle().
-					Background(lipgloss.Color("250")).
-					Foreground(lipgloss.Color("56"))
+					Background(theme.HighlightBackground.Color()).
+					Foreground(theme.HighlightText.Color())
 )
 
+// profileSelectedItemView marks the selected item, with the brackets in the monochrome theme.
+func profileSelectedItemView(s string) string {
+	if theme.Monochrome() {
+		return "[" + s + "]"
+	}
+	return profileSelectedItemColorStyle.Render(s)
+}
+
 type profileSelectableItem int
 
 const (
@@ -50,6 +59,14 @@ type profileKeyMap struct {
 	Quit     key.Binding
 }
 
+var profileKeys = registerKeyScope("profile",
+	newKeyAction("next-item", "tab", "select item", "tab"),
+	newKeyAction("prev-item", "shift+tab", "select item (reverse)", "shift+tab"),
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
 func (k profileKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.Tab,
@@ -94,26 +111,11 @@ type profileModel struct {
 
 func newProfileModel(client *gh.GitHubClient, s *spinner.Model) profileModel {
 	profileKeys := &profileKeyMap{
-		Tab: key.NewBinding(
-			key.WithKeys("tab"),
-			key.WithHelp("tab", "select item"),
-		),
-		ShiftTab: key.NewBinding(
-			key.WithKeys("shift+tab"),
-			key.WithHelp("shift+tab", "select item (reverse)"),
-		),
-		Open: key.NewBinding(
-			key.WithKeys("x"),
-			key.WithHelp("x", "open in browser"),
-		),
-		Back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		Quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		Tab:      profileKeys.binding("next-item"),
+		ShiftTab: profileKeys.binding("prev-item"),
+		Open:     profileKeys.binding("open"),
+		Back:     profileKeys.binding("back"),
+		Quit:     profileKeys.binding("quit"),
 	}
 	return profileModel{
 		client:       client,
@@ -125,6 +127,14 @@ func newProfileModel(client *gh.GitHubClient, s *spinner.Model) profileModel {
 	}
 }
 
+func init() {
+	registerPage(func(ctx *pageContext, _ selectProfilePageMsg) page {
+		m := newProfileModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+}
+
 func (m *profileModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
@@ -153,20 +163,12 @@ func (m *profileModel) selectItem(reverse bool) {
 	} else {
 		m.selectedItem = (m.selectedItem + 1) % numberOfItems
 	}
-	switch m.selectedItem {
-	case profileAccountItem:
-		// do nothing
-	case profileCompanyItem:
-		if !isOrganizationLogin(m.profile.Company) {
-			m.selectItem(reverse)
-		}
-	case profileWebsiteItem:
-		if !isUrl(m.profile.WebsiteUrl) {
-			m.selectItem(reverse)
-		}
-	default:
-		m.selectedItem = profileNotSelectedItem
+	if m.selectedItem == profileNotSelectedItem {
 		m.keys.Open.SetEnabled(false)
+		return
+	}
+	if !m.selectable(m.selectedItem) {
+		m.selectItem(reverse)
 	}
 }
 
@@ -232,10 +234,23 @@ func (m profileModel) Update(msg tea.Msg) (profileModel, tea.Cmd) {
 		case key.Matches(msg, m.keys.Open):
 			return m, m.openInBrowser()
 		case key.Matches(msg, m.keys.Back):
-			return m, goBackMenuPage
+			return m, goBack
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
 		}
+	case tea.MouseMsg:
+		if m.loading || m.errorMsg != nil || m.profile == nil {
+			return m, nil
+		}
+		if clickedRow(msg, 0) >= 0 {
+			if item := m.itemAt(msg); item != profileNotSelectedItem {
+				m.selectedItem = item
+				m.keys.Open.SetEnabled(true)
+				m.updateContent()
+				return m, m.openInBrowser()
+			}
+			return m, nil
+		}
 	case selectProfilePageMsg:
 		m.loading = true
 		return m, m.loadProfile(msg.id)
@@ -257,6 +272,24 @@ func (m profileModel) Update(msg tea.Msg) (profileModel, tea.Cmd) {
 	return m, cmd
 }
 
+func (m profileModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+// yankTargets returns the selected item of the profile, or the account.
+func (m profileModel) yankTargets() (*yankTarget, []*yankTarget) {
+	if m.loading || m.errorMsg != nil || m.profile == nil {
+		return nil, nil
+	}
+	switch {
+	case m.selectedItem == profileCompanyItem && m.selectable(profileCompanyItem):
+		return userYankTarget(strings.TrimSpace(strings.TrimLeft(m.profile.Company, "@"))), nil
+	case m.selectedItem == profileWebsiteItem && m.selectable(profileWebsiteItem):
+		return &yankTarget{url: m.profile.WebsiteUrl, ref: m.profile.WebsiteUrl}, nil
+	}
+	return userYankTarget(m.profile.Login), nil
+}
+
 func (m profileModel) View() string {
 	if m.loading {
 		return loadingView(m.spinner, m.breadcrumb())
@@ -293,28 +326,75 @@ func (m profileModel) profieView() string {
 }
 
 func (m profileModel) profieContentsView() string {
+	contents, _ := m.profileContents()
+	return contents
+}
+
+// profileContents renders the profile, and returns the lines of the selectable items in it.
+func (m profileModel) profileContents() (string, map[profileSelectableItem]int) {
+	lines := make(map[profileSelectableItem]int)
+	t, _, _, _ := profileItemStyle.GetPadding()
 	ret := ""
 	ret += profileItemNameStyle.Render(m.profile.Name)
 	login := "@" + m.profile.Login
 	if m.selectedItem == profileAccountItem {
-		login = profileSelectedItemColorStyle.Render(login)
+		login = profileSelectedItemView(login)
 	}
+	lines[profileAccountItem] = cn(ret) + t
 	ret += profileItemStyle.Render(login)
 	ret += profileItemStyle.Render(m.profile.Bio)
 	ret += "\n"
-	ret += profileItemStyle.Render(fmt.Sprintf("%d followers - %d following", m.profile.Followers, m.profile.Following))
+	if m.profile.IsOrganization {
+		ret += profileItemStyle.Render(fmt.Sprintf("%d members", m.profile.Members))
+	} else {
+		ret += profileItemStyle.Render(fmt.Sprintf("%d followers - %d following", m.profile.Followers, m.profile.Following))
+	}
 	company := m.profile.Company
 	if m.selectedItem == profileCompanyItem {
-		company = profileSelectedItemColorStyle.Render(company)
+		company = profileSelectedItemView(company)
 	}
+	lines[profileCompanyItem] = cn(ret) + t
 	ret += profileItemStyle.Render("🏢 " + company)
 	ret += profileItemStyle.Render("🌐 " + m.profile.Location)
 	websiteUrl := m.profile.WebsiteUrl
 	if m.selectedItem == profileWebsiteItem {
-		websiteUrl = profileSelectedItemColorStyle.Render(websiteUrl)
+		websiteUrl = profileSelectedItemView(websiteUrl)
 	}
+	lines[profileWebsiteItem] = cn(ret) + t
 	ret += profileItemStyle.Render("🔗 " + websiteUrl)
-	return ret
+	return ret, lines
+}
+
+// itemAt returns the selectable item at the position of the mouse, or profileNotSelectedItem.
+func (m profileModel) itemAt(msg tea.MouseMsg) profileSelectableItem {
+	t, _, _, _ := profileViewportStyle.GetPadding()
+	// the viewport follows the title
+	y := msg.Y - 1 - t + m.viewport.YOffset
+	contents, lines := m.profileContents()
+	rows := strings.Split(contents, "\n")
+	_, _, _, l := profileItemStyle.GetPadding()
+	for item, line := range lines {
+		if line != y || line >= len(rows) || !m.selectable(item) {
+			continue
+		}
+		if l <= msg.X && msg.X < lipgloss.Width(strings.TrimRight(rows[line], " ")) {
+			return item
+		}
+	}
+	return profileNotSelectedItem
+}
+
+// selectable reports whether the item can be opened in the browser.
+func (m profileModel) selectable(item profileSelectableItem) bool {
+	switch item {
+	case profileAccountItem:
+		return true
+	case profileCompanyItem:
+		return isOrganizationLogin(m.profile.Company)
+	case profileWebsiteItem:
+		return isUrl(m.profile.WebsiteUrl)
+	}
+	return false
 }
 
 func (m profileModel) errorView() string {
@@ -1,41 +1,40 @@
 package ui
 
 import (
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 var (
 	pullRequestsErrorStyle = lipgloss.NewStyle().
 		Padding(2, 0, 0, 2).
-		Foreground(lipgloss.Color("161"))
+		Foreground(theme.Error.Color())
 )
 
-type pullRequestsInnerPage int
-
-const (
-	pullRequestsOwnerPage pullRequestsInnerPage = iota
-	pullRequestsRepositoryPage
-	pullRequestsListPage
-	pullRequestsListAllPage
-)
+// pullRequestsFocus points to the pull request to show when the page is opened from another page.
+type pullRequestsFocus struct {
+	owner      string
+	repository string
+	number     int
+}
 
+// pullRequestsModel loads the pull requests and shows them grouped by the owners.
+// The repositories of an owner, the pull requests of a repository and the list of all pull requests are separate pages.
 type pullRequestsModel struct {
-	client      *gh.GitHubClient
-	currentPage pullRequestsInnerPage
+	client *gh.GitHubClient
 
 	prs *gh.UserPullRequests
 
 	owner   *pullRequestsOwnerModel
-	repo    *pullRequestsRepositoryModel
-	list    *pullRequestsListModel
-	listAll *pullRequestsListAllModel
 	spinner *spinner.Model
 
 	errorMsg      *pullRequestsErrorMsg
 	loading       bool
+	focus         *pullRequestsFocus
 	selectedUser  string
 	width, height int
 }
@@ -44,28 +43,42 @@ func newPullRequestsModel(client *gh.GitHubClient, s *spinner.Model) pullRequest
 	return pullRequestsModel{
 		client:  client,
 		owner:   newPullRequestsOwnerModel(),
-		repo:    newPullRequestsRepositoryModel(),
-		list:    newPullRequestsListModel(),
-		listAll: newPullRequestsListAllModel(),
 		spinner: s,
 	}
 }
 
+func init() {
+	registerPage(func(ctx *pageContext, _ selectPullRequestsPageMsg) page {
+		m := newPullRequestsModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+	registerPage(func(ctx *pageContext, _ selectPullRequestsOwnerMsg) page {
+		m := newPullRequestsRepositoryModel()
+		m.SetUser(ctx.user)
+		return newRoutedPage(*m)
+	})
+	registerPage(func(ctx *pageContext, _ selectPullRequestsRepositoryMsg) page {
+		m := newPullRequestsListModel(ctx.client)
+		m.SetUser(ctx.user)
+		return newRoutedPage(*m)
+	})
+	registerPage(func(ctx *pageContext, _ togglePullRequestsListAllMsg) page {
+		m := newPullRequestsListAllModel(ctx.client)
+		m.SetUser(ctx.user)
+		return newRoutedPage(*m)
+	})
+}
+
 func (m *pullRequestsModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
 	m.owner.SetSize(width, height)
-	m.repo.SetSize(width, height)
-	m.list.SetSize(width, height)
-	m.listAll.SetSize(width, height)
 }
 
 func (m *pullRequestsModel) SetUser(id string) {
 	m.selectedUser = id
 	m.owner.SetUser(id)
-	m.repo.SetUser(id)
-	m.list.SetUser(id)
-	m.listAll.SetUser(id)
 }
 
 func (m pullRequestsModel) Init() tea.Cmd {
@@ -97,25 +110,19 @@ func (m pullRequestsModel) loadPullRequests(id string) tea.Cmd {
 
 type selectPullRequestsOwnerMsg struct {
 	owner *gh.UserPullRequestsOwner
+	focus *pullRequestsFocus // the pull request to open after selecting the owner, if not nil
 }
 
 var _ tea.Msg = (*selectPullRequestsOwnerMsg)(nil)
 
 type selectPullRequestsRepositoryMsg struct {
-	repo  *gh.UserPullRequestsRepository
-	owner string
+	repo   *gh.UserPullRequestsRepository
+	owner  string
+	number int // the pull request to select, if not 0
 }
 
 var _ tea.Msg = (*selectPullRequestsRepositoryMsg)(nil)
 
-type togglePullRequestsListMsg struct{}
-
-var _ tea.Msg = (*togglePullRequestsListMsg)(nil)
-
-func togglePullRequestsList() tea.Msg {
-	return togglePullRequestsListMsg{}
-}
-
 type togglePullRequestsListAllMsg struct {
 	prs *gh.UserPullRequests
 }
@@ -128,25 +135,12 @@ func togglePullRequestsListAll(prs *gh.UserPullRequests) tea.Cmd {
 	}
 }
 
-type goBackPullRequestsOwnerPageMsg struct{}
-
-var _ tea.Msg = (*goBackPullRequestsOwnerPageMsg)(nil)
-
-func goBackPullRequestsOwnerPage() tea.Msg {
-	return goBackPullRequestsOwnerPageMsg{}
-}
-
-type goBackPullRequestsRepositoryPageMsg struct{}
-
-var _ tea.Msg = (*goBackPullRequestsRepositoryPageMsg)(nil)
-
-func goBackPullRequestsRepositoryPage() tea.Msg {
-	return goBackPullRequestsRepositoryPageMsg{}
+func (m pullRequestsModel) capturingInput() bool {
+	return !m.loading && m.errorMsg == nil && m.owner.capturingInput()
 }
 
 func (m pullRequestsModel) Update(msg tea.Msg) (pullRequestsModel, tea.Cmd) {
 	var cmd tea.Cmd
-	cmds := make([]tea.Cmd, 0)
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		if m.loading {
@@ -154,48 +148,52 @@ func (m pullRequestsModel) Update(msg tea.Msg) (pullRequestsModel, tea.Cmd) {
 		}
 	case selectPullRequestsPageMsg:
 		m.loading = true
+		m.focus = msg.focus
 		return m, m.loadPullRequests(msg.id)
-	case selectPullRequestsOwnerMsg:
-		m.currentPage = pullRequestsRepositoryPage
-	case selectPullRequestsRepositoryMsg:
-		m.currentPage = pullRequestsListPage
-	case togglePullRequestsListMsg:
-		m.currentPage = pullRequestsOwnerPage
-	case togglePullRequestsListAllMsg:
-		m.currentPage = pullRequestsListAllPage
-	case goBackPullRequestsOwnerPageMsg:
-		m.currentPage = pullRequestsOwnerPage
-	case goBackPullRequestsRepositoryPageMsg:
-		m.currentPage = pullRequestsRepositoryPage
 	case pullRequestsSuccessMsg:
 		m.errorMsg = nil
 		m.loading = false
 		m.prs = msg.prs
-		m.currentPage = pullRequestsOwnerPage
+		*m.owner, cmd = m.owner.Update(msg)
+		if m.focus != nil {
+			focus := m.focus
+			m.focus = nil
+			return m, tea.Batch(cmd, m.focusPullRequest(focus))
+		}
+		return m, cmd
 	case pullRequestsErrorMsg:
 		m.errorMsg = &msg
 		m.loading = false
 		return m, nil
 	}
 
-	switch m.currentPage {
-	case pullRequestsOwnerPage:
-		*m.owner, cmd = m.owner.Update(msg)
-		cmds = append(cmds, cmd)
-	case pullRequestsRepositoryPage:
-		*m.repo, cmd = m.repo.Update(msg)
-		cmds = append(cmds, cmd)
-	case pullRequestsListPage:
-		*m.list, cmd = m.list.Update(msg)
-		cmds = append(cmds, cmd)
-	case pullRequestsListAllPage:
-		*m.listAll, cmd = m.listAll.Update(msg)
-		cmds = append(cmds, cmd)
-	default:
-		return m, nil
+	*m.owner, cmd = m.owner.Update(msg)
+	return m, cmd
+}
+
+// focusPullRequest opens the pages of the owner and the repository and selects the pull request,
+// as if the user had selected them.
+func (m *pullRequestsModel) focusPullRequest(focus *pullRequestsFocus) tea.Cmd {
+	owner := m.prs.Owner(focus.owner)
+	if owner == nil {
+		return nil
 	}
+	m.owner.focus(owner.Name)
+	return func() tea.Msg { return selectPullRequestsOwnerMsg{owner, focus} }
+}
 
-	return m, tea.Batch(cmds...)
+func (m pullRequestsModel) keyBindings() [][]key.Binding {
+	if m.loading || m.errorMsg != nil {
+		return nil
+	}
+	return m.owner.keyBindings()
+}
+
+func (m pullRequestsModel) yankTargets() (*yankTarget, []*yankTarget) {
+	if m.loading || m.errorMsg != nil {
+		return nil, nil
+	}
+	return m.owner.yankTargets()
 }
 
 func (m pullRequestsModel) View() string {
@@ -205,19 +203,7 @@ func (m pullRequestsModel) View() string {
 	if m.errorMsg != nil {
 		return m.errorView()
 	}
-
-	switch m.currentPage {
-	case pullRequestsOwnerPage:
-		return m.owner.View()
-	case pullRequestsRepositoryPage:
-		return m.repo.View()
-	case pullRequestsListPage:
-		return m.list.View()
-	case pullRequestsListAllPage:
-		return m.listAll.View()
-	default:
-		return baseStyle.Render("error... :(")
-	}
+	return m.owner.View()
 }
 
 func (m pullRequestsModel) errorView() string {
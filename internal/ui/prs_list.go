@@ -8,10 +8,15 @@ import (
 )
 
 type pullRequestsListModel struct {
-	prs []*gh.UserPullRequestsPullRequest
+	client *gh.GitHubClient
+
+	prs        []*gh.UserPullRequestsPullRequest
+	repository *gh.UserPullRequestsRepository
 
 	list         list.Model
+	delegate     pullRequestsListDelegate
 	delegateKeys pullRequestsListDelegateKeyMap
+	preview      *previewPane
 
 	selectedUser       string
 	selectedOwner      string
@@ -20,48 +25,56 @@ type pullRequestsListModel struct {
 }
 
 type pullRequestsListDelegateKeyMap struct {
-	open key.Binding
-	back key.Binding
-	quit key.Binding
+	open    key.Binding
+	back    key.Binding
+	quit    key.Binding
+	preview previewKeyMap
 }
 
+var pullRequestsListKeys = registerKeyScope("prs-list",
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+).with("list", "preview")
+
 func newPullRequestsListDelegateKeyMap() pullRequestsListDelegateKeyMap {
 	return pullRequestsListDelegateKeyMap{
-		open: key.NewBinding(
-			key.WithKeys("x"),
-			key.WithHelp("x", "open in browser"),
-		),
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		open:    pullRequestsListKeys.binding("open"),
+		back:    pullRequestsListKeys.binding("back"),
+		quit:    pullRequestsListKeys.binding("quit"),
+		preview: newPreviewKeyMap(),
 	}
 }
 
-func newPullRequestsListModel() *pullRequestsListModel {
+func newPullRequestsListModel(client *gh.GitHubClient) *pullRequestsListModel {
 	delegateKeys := newPullRequestsListDelegateKeyMap()
 	delegate := newPullRequestsListDelegate(delegateKeys)
 
 	l := list.New(nil, delegate, 0, 0)
 	l.KeyMap.Quit = delegateKeys.quit
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 
 	return &pullRequestsListModel{
+		client:       client,
 		list:         l,
+		delegate:     delegate,
 		delegateKeys: delegateKeys,
+		preview:      newPreviewPane(),
 	}
 }
 
 func (m *pullRequestsListModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.list.SetSize(width, height-2)
+	m.updateListSize()
+}
+
+func (m *pullRequestsListModel) updateListSize() {
+	m.preview.SetSize(m.width, m.height-2)
+	m.list.SetSize(m.preview.listWidth(), m.height-2)
 }
 
 func (m *pullRequestsListModel) SetUser(id string) {
@@ -72,8 +85,9 @@ func (m *pullRequestsListModel) setOwner(name string) {
 	m.selectedOwner = name
 }
 
-func (m *pullRequestsListModel) setRepository(name string) {
-	m.selectedRepository = name
+func (m *pullRequestsListModel) setRepository(repo *gh.UserPullRequestsRepository) {
+	m.repository = repo
+	m.selectedRepository = repo.Name
 }
 
 func (m *pullRequestsListModel) updateList(prs []*gh.UserPullRequestsPullRequest) {
@@ -98,6 +112,15 @@ func (m *pullRequestsListModel) updateList(prs []*gh.UserPullRequestsPullRequest
 	m.list.SetItems(items)
 }
 
+func (m *pullRequestsListModel) focus(number int) {
+	for i, item := range m.list.Items() {
+		if item.(pullRequestsListItem).number == number {
+			m.list.Select(i)
+			return
+		}
+	}
+}
+
 func (m pullRequestsListModel) Init() tea.Cmd {
 	return nil
 }
@@ -111,32 +134,92 @@ func (m pullRequestsListModel) openPullRequestPageInBrowser(item pullRequestsLis
 	}
 }
 
+func (m pullRequestsListModel) capturingInput() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
+func (m *pullRequestsListModel) updatePreview() tea.Cmd {
+	if !m.preview.visible() || m.repository == nil {
+		return nil
+	}
+	item, ok := m.list.SelectedItem().(pullRequestsListItem)
+	if !ok {
+		return m.preview.show(nil)
+	}
+	for _, pr := range m.prs {
+		if pr.Number == item.number {
+			return m.preview.show(pullRequestPreview(m.client, m.selectedOwner, m.repository, pr))
+		}
+	}
+	return nil
+}
+
 func (m pullRequestsListModel) Update(msg tea.Msg) (pullRequestsListModel, tea.Cmd) {
+	m, cmd := m.update(msg)
+	return m, tea.Batch(cmd, m.updatePreview())
+}
+
+func (m pullRequestsListModel) update(msg tea.Msg) (pullRequestsListModel, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+		if m.preview.updateKey(msg, m.delegateKeys.preview) {
+			m.updateListSize()
+			return m, nil
+		}
 		switch {
 		case key.Matches(msg, m.delegateKeys.open):
 			item := m.list.SelectedItem().(pullRequestsListItem)
 			return m, m.openPullRequestPageInBrowser(item)
 		case key.Matches(msg, m.delegateKeys.back):
 			if m.list.FilterState() != list.Filtering {
-				return m, goBackPullRequestsRepositoryPage
+				return m, goBack
 			}
 		}
+	case tea.MouseMsg:
+		if m.preview.updateMouse(msg) {
+			return m, nil
+		}
+		if updateListMouse(&m.list, m.delegate, msg, m.itemsTop()) {
+			return m.update(keyPress(m.delegateKeys.open))
+		}
+		return m, nil
 	case selectPullRequestsRepositoryMsg:
 		m.list.ResetSelected()
 		m.updateList(msg.repo.PullRequests)
-		m.setRepository(msg.repo.Name)
+		m.setRepository(msg.repo)
 		m.setOwner(msg.owner)
+		if msg.number != 0 {
+			m.focus(msg.number)
+		}
 		return m, nil
+	case previewLoadMsg, previewBodyMsg:
+		return m, m.preview.update(msg)
 	}
 	m.list, cmd = m.list.Update(msg)
 	return m, cmd
 }
 
+func (m pullRequestsListModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
+}
+
+func (m pullRequestsListModel) yankTargets() (*yankTarget, []*yankTarget) {
+	return listYankTargets(m.list, func(item pullRequestsListItem) *yankTarget {
+		return pullRequestYankTarget(m.selectedOwner+"/"+m.selectedRepository, item.number, item.title, item.url)
+	})
+}
+
+func (m pullRequestsListModel) itemsTop() int {
+	// below the title
+	return 2 + listItemsOffset(m.list)
+}
+
 func (m pullRequestsListModel) View() string {
-	return titleView(m.breadcrumb()) + listView(m.list)
+	return titleView(m.breadcrumb()) + m.preview.splitView(listView(m.list))
 }
 
 func (m pullRequestsListModel) breadcrumb() []string {
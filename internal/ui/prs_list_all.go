@@ -2,7 +2,6 @@ package ui
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -10,130 +9,251 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lusingander/ghcv-cli/internal/gh"
-	"github.com/lusingander/kasane"
+	"github.com/lusingander/ghcv-cli/internal/query"
 )
 
-var (
-	pullRequestsListAllDialogBodyStyle = lipgloss.NewStyle().
-						Padding(0, 2)
-
-	pullRequestsListAllDialogStyle = lipgloss.NewStyle().
-					BorderStyle(lipgloss.RoundedBorder())
-
-	pullRequestsListAllDialogSelectedStyle = lipgloss.NewStyle().
-						Foreground(selectedColor1)
-
-	pullRequestsListAllDialogNotSelectedStyle = lipgloss.NewStyle()
+const (
+	// identifies the page in the remembered sort orders and the saved views
+	pullRequestsListAllPageKey = "pullRequestsListAll"
 )
 
-type pullRequestListAllSortType int
+func pullRequestsListAllSortFields() []*sortField {
+	pr := func(i list.Item) pullRequestsListAllItem {
+		return i.(pullRequestsListAllItem)
+	}
+	return []*sortField{
+		{
+			name:        "Created",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareTime(pr(a).createdAt, pr(b).createdAt) },
+		},
+		{
+			name:        "Closed",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareTime(pr(a).closedAt, pr(b).closedAt) },
+		},
+		{
+			name:        "Changes (+/-)",
+			defaultDesc: true,
+			compare: func(a, b list.Item) int {
+				return compareInt(pr(a).additions+pr(a).deletions, pr(b).additions+pr(b).deletions)
+			},
+		},
+		{
+			name:        "Repository Stars",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareInt(pr(a).repositoryStars, pr(b).repositoryStars) },
+		},
+		{
+			name:        "Repository",
+			defaultDesc: false,
+			compare: func(a, b list.Item) int {
+				return compareString(pr(a).owner+"/"+pr(a).repository, pr(b).owner+"/"+pr(b).repository)
+			},
+		},
+		{
+			name:        "Title",
+			defaultDesc: false,
+			compare:     func(a, b list.Item) int { return compareString(pr(a).title, pr(b).title) },
+		},
+	}
+}
 
-const (
-	pullRequestListAllSortByCreatedAtDesc pullRequestListAllSortType = iota
-	pullRequestListAllSortByCreatedAtAsc
-)
+func pullRequestsListAllTableColumns() []*tableColumn {
+	pr := func(i list.Item) pullRequestsListAllItem {
+		return i.(pullRequestsListAllItem)
+	}
+	return []*tableColumn{
+		{
+			title: "Status",
+			width: 7,
+			value: func(i list.Item) string { return pr(i).status },
+		},
+		{
+			title:  "Repository",
+			width:  24,
+			sortBy: "Repository",
+			value:  func(i list.Item) string { return pr(i).repositoryFullName() },
+		},
+		{
+			title: "#",
+			width: 7,
+			align: lipgloss.Right,
+			value: func(i list.Item) string { return fmt.Sprintf("#%d", pr(i).number) },
+		},
+		{
+			title:  "Title",
+			flex:   true,
+			sortBy: "Title",
+			value:  func(i list.Item) string { return pr(i).title },
+		},
+		{
+			title:  "+/−",
+			width:  13,
+			align:  lipgloss.Right,
+			sortBy: "Changes (+/-)",
+			value:  func(i list.Item) string { return fmt.Sprintf("+%d −%d", pr(i).additions, pr(i).deletions) },
+		},
+		{
+			title:  "Created",
+			width:  12,
+			sortBy: "Created",
+			value:  func(i list.Item) string { return formatShortDate(pr(i).createdAt) },
+		},
+		{
+			title:  "Closed",
+			width:  12,
+			sortBy: "Closed",
+			value:  func(i list.Item) string { return formatShortDate(pr(i).closedAt) },
+		},
+	}
+}
 
-type pullRequestStatus struct {
-	name  string
-	count int
+var pullRequestsListAllDefaultSortOrder = sortOrder{
+	primary:     0, // Created
+	primaryDesc: true,
+	secondary:   noSortField,
 }
 
 type pullRequestsListAllModel struct {
+	client *gh.GitHubClient
+
 	prs *gh.UserPullRequests
 
-	list                           list.Model
-	originalItems                  []list.Item
-	delegateKeys                   pullRequestsListAllDelegateKeyMap
-	filterStatusDialogDelegateKeys pullRequestsListAllFilterStatusDialogDelegateKeyMap
+	list          list.Model
+	originalItems []list.Item
+	delegate      pullRequestsListAllDelegate
+	delegateKeys  pullRequestsListAllDelegateKeyMap
 
 	selectedUser  string
 	width, height int
 
-	pullRequestListAllSortType
+	sorter     *itemSorter
+	sortDialog *sortDialog
 
-	statuses           []*pullRequestStatus
-	statusIdx          int
-	statusDialogOpened bool
+	viewsDialog *savedViewsDialog
+
+	query       *queryPrompt
+	queryFilter func(*query.PullRequest) bool
+
+	statusDialog *selectDialog
+
+	preview *previewPane
+	table   *table
 }
 
 type pullRequestsListAllDelegateKeyMap struct {
-	stat key.Binding
-	open key.Binding
-	back key.Binding
-	tog  key.Binding
-	quit key.Binding
+	sort    key.Binding
+	stat    key.Binding
+	query   key.Binding
+	views   key.Binding
+	open    key.Binding
+	back    key.Binding
+	tog     key.Binding
+	quit    key.Binding
+	preview previewKeyMap
+	table   tableKeyMap
 }
 
+var pullRequestsListAllKeys = registerKeyScope("prs-all",
+	newKeyAction("sort", "S", "sort", "S"),
+	newKeyAction("status", "T", "filter by status", "T"),
+	newKeyAction("query", ":", "filter by query", ":"),
+	newKeyAction("views", "V", "saved views", "V"),
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("toggle", "tab", "toggle", "tab"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+).with("list", "preview", "table")
+
 func newPullRequestsListAllDelegateKeyMap() pullRequestsListAllDelegateKeyMap {
 	return pullRequestsListAllDelegateKeyMap{
-		stat: key.NewBinding(
-			key.WithKeys("T"),
-			key.WithHelp("T", "filter by status"),
-		),
-		open: key.NewBinding(
-			key.WithKeys("x"),
-			key.WithHelp("x", "open in browser"),
-		),
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		tog: key.NewBinding(
-			key.WithKeys("tab"),
-			key.WithHelp("tab", "toggle"),
-		),
-		quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		sort:    pullRequestsListAllKeys.binding("sort"),
+		stat:    pullRequestsListAllKeys.binding("status"),
+		query:   pullRequestsListAllKeys.binding("query"),
+		views:   pullRequestsListAllKeys.binding("views"),
+		open:    pullRequestsListAllKeys.binding("open"),
+		back:    pullRequestsListAllKeys.binding("back"),
+		tog:     pullRequestsListAllKeys.binding("toggle"),
+		quit:    pullRequestsListAllKeys.binding("quit"),
+		preview: newPreviewKeyMap(),
+		table:   newTableKeyMap(),
 	}
 }
 
-type pullRequestsListAllFilterStatusDialogDelegateKeyMap struct {
-	next  key.Binding
-	prev  key.Binding
-	close key.Binding
-}
-
-func newPullRequestsListAllFilterStatusDialogDelegateKeyMap() pullRequestsListAllFilterStatusDialogDelegateKeyMap {
-	return pullRequestsListAllFilterStatusDialogDelegateKeyMap{
-		next: key.NewBinding(
-			key.WithKeys("j"),
-			key.WithHelp("j", "select next"),
-		),
-		prev: key.NewBinding(
-			key.WithKeys("k"),
-			key.WithHelp("k", "select prev"),
-		),
-		close: key.NewBinding(
-			key.WithKeys("T", "esc", "enter"),
-			key.WithHelp("T", "close dialog"),
-		),
-	}
-}
-
-func newPullRequestsListAllModel() *pullRequestsListAllModel {
+func newPullRequestsListAllModel(client *gh.GitHubClient) *pullRequestsListAllModel {
 	delegateKeys := newPullRequestsListAllDelegateKeyMap()
 	delegate := newPullRequestsListAllDelegate(delegateKeys)
-	filterStatusDialogDelegateKeys := newPullRequestsListAllFilterStatusDialogDelegateKeyMap()
 
-	l := list.New(nil, delegate, 0, 0)
+	sorter := newItemSorter(pullRequestsListAllPageKey, pullRequestsListAllSortFields(), pullRequestsListAllDefaultSortOrder)
+	table := newTable(pullRequestsListAllPageKey, pullRequestsListAllTableColumns(), sorter)
+
+	l := list.New(nil, table.listDelegate(delegate, delegateKeys.table), 0, 0)
 	l.KeyMap.Quit = delegateKeys.quit
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 
 	return &pullRequestsListAllModel{
-		list:                           l,
-		delegateKeys:                   delegateKeys,
-		filterStatusDialogDelegateKeys: filterStatusDialogDelegateKeys,
+		client:       client,
+		list:         l,
+		delegate:     delegate,
+		delegateKeys: delegateKeys,
+		sorter:       sorter,
+		sortDialog:   newSortDialog(sorter, delegateKeys.sort.Keys()),
+		viewsDialog:  newSavedViewsDialog(pullRequestsListAllPageKey, delegateKeys.views.Keys()),
+		query:        newQueryPrompt(),
+		statusDialog: newSelectDialog("Status", delegateKeys.stat.Keys(), true),
+		preview:      newPreviewPane(),
+		table:        table,
 	}
 }
 
 func (m *pullRequestsListAllModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.list.SetSize(width, height-2)
+	m.updateListSize()
+	m.sortDialog.SetSize(width, height)
+	m.statusDialog.SetSize(width, height)
+	m.viewsDialog.SetSize(width, height)
+}
+
+func (m *pullRequestsListAllModel) updateListSize() {
+	height := m.height - 2 - m.query.height()
+	m.preview.SetSize(m.width, height)
+	if m.table.enabled {
+		// the header of the table
+		height--
+	}
+	m.list.SetSize(m.preview.listWidth(), height)
+	m.table.SetWidth(m.preview.listWidth() - listNormalItemStyle.GetHorizontalFrameSize())
+}
+
+func (m pullRequestsListAllModel) itemsTop() int {
+	top := 2 + m.query.height() + listItemsOffset(m.list)
+	if m.table.enabled {
+		// the header of the table
+		top++
+	}
+	return top
+}
+
+func (m pullRequestsListAllModel) tableHeaderY() int {
+	// below the title, the query and the filter input
+	return 3 + m.query.height()
+}
+
+func (m *pullRequestsListAllModel) updatePreview() tea.Cmd {
+	if !m.preview.visible() {
+		return nil
+	}
+	item, ok := m.list.SelectedItem().(pullRequestsListAllItem)
+	if !ok {
+		return m.preview.show(nil)
+	}
+	pr := item.pullRequest
+	return m.preview.show(pullRequestPreview(m.client, pr.Owner, pr.Repository, pr.UserPullRequestsPullRequest))
 }
 
 func (m *pullRequestsListAllModel) SetUser(id string) {
@@ -145,84 +265,112 @@ func (m *pullRequestsListAllModel) updatePrs(prs *gh.UserPullRequests) {
 
 	items := make([]list.Item, 0)
 	statusesMap := make(map[string]int)
-	for _, owner := range m.prs.Owners {
-		for _, repo := range owner.Repositories {
-			for _, pr := range repo.PullRequests {
-				created := formatDuration(pr.CretaedAt)
-				closed := formatDuration(pr.ClosedAt)
-				item := pullRequestsListAllItem{
-					owner:      owner.Name,
-					repository: repo.Name,
-					createdAt:  pr.CretaedAt,
-					closedAt:   pr.ClosedAt,
-					pullRequestsListItem: pullRequestsListItem{
-						title:     pr.Title,
-						status:    pr.State,
-						number:    pr.Number,
-						additions: pr.Additions,
-						deletions: pr.Deletions,
-						comments:  pr.Comments,
-						created:   created,
-						closed:    closed,
-						url:       pr.Url,
-					},
-				}
-				items = append(items, item)
-				statusesMap[pr.State] += 1
-			}
+	for _, pr := range query.PullRequests(m.prs) {
+		created := formatDuration(pr.CretaedAt)
+		closed := formatDuration(pr.ClosedAt)
+		item := pullRequestsListAllItem{
+			owner:           pr.Owner,
+			repository:      pr.Repository.Name,
+			repositoryStars: pr.Repository.Stars,
+			createdAt:       pr.CretaedAt,
+			closedAt:        pr.ClosedAt,
+			pullRequest:     pr,
+			pullRequestsListItem: pullRequestsListItem{
+				title:     pr.Title,
+				status:    pr.State,
+				number:    pr.Number,
+				additions: pr.Additions,
+				deletions: pr.Deletions,
+				comments:  pr.Comments,
+				created:   created,
+				closed:    closed,
+				url:       pr.Url,
+			},
 		}
+		items = append(items, item)
+		statusesMap[pr.State] += 1
 	}
-	m.list.SetItems(items)
 	m.originalItems = items
-	m.sortItems()
 
-	m.statuses = []*pullRequestStatus{
-		{name: "All", count: len(items)},
-		{name: "OPEN", count: statusesMap["OPEN"]},
-		{name: "MERGED", count: statusesMap["MERGED"]},
-		{name: "CLOSED", count: statusesMap["CLOSED"]},
+	statusItems := make([]selectDialogItem, 0)
+	for _, status := range []string{"OPEN", "MERGED", "CLOSED"} {
+		statusItems = append(statusItems, selectDialogItem{name: status, note: fmt.Sprintf("(%d)", statusesMap[status])})
 	}
-	m.statusIdx = 0
+	m.statusDialog.setChecked(nil)
+	m.statusDialog.setItems(statusItems)
+
+	if v := defaultSavedView(pullRequestsListAllPageKey); v != nil {
+		m.applyView(v)
+		return
+	}
+	m.updateListItems()
 }
 
-func (m *pullRequestsListAllModel) sortItems() {
-	items := m.list.Items()
-	switch m.pullRequestListAllSortType {
-	case pullRequestListAllSortByCreatedAtDesc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].(pullRequestsListAllItem).createdAt.After(items[j].(pullRequestsListAllItem).createdAt)
-		})
-	case pullRequestListAllSortByCreatedAtAsc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].(pullRequestsListAllItem).createdAt.Before(items[j].(pullRequestsListAllItem).createdAt)
-		})
+func (m *pullRequestsListAllModel) updateListItems() tea.Cmd {
+	statuses := m.statusDialog.checkedNames()
+	items := make([]list.Item, 0)
+	for _, i := range m.originalItems {
+		item := i.(pullRequestsListAllItem)
+		if len(statuses) > 0 && !m.statusDialog.isChecked(item.status) {
+			continue
+		}
+		if m.queryFilter != nil && !m.queryFilter(item.pullRequest) {
+			continue
+		}
+		items = append(items, i)
 	}
-	m.list.SetItems(items)
+	return m.list.SetItems(m.sorter.sort(items))
 }
 
-func (m *pullRequestsListAllModel) updateStatusIdx(reverse bool) {
-	n := len(m.statuses)
-	if reverse {
-		m.statusIdx = ((m.statusIdx-1)%n + n) % n
-	} else {
-		m.statusIdx = (m.statusIdx + 1) % n
+func (m *pullRequestsListAllModel) setQuery(s string) error {
+	q, err := query.Parse(s)
+	if err != nil {
+		return err
+	}
+	filter, err := query.Compile(q, query.PullRequestSchema)
+	if err != nil {
+		return err
 	}
+	if q.Empty() {
+		filter = nil
+	}
+	m.queryFilter = filter
+	m.query.apply(strings.TrimSpace(s))
+	m.updateListSize()
+	return nil
 }
 
-func (m *pullRequestsListAllModel) filterItems() {
-	if m.statuses[m.statusIdx].name == "All" {
-		m.list.SetItems(m.originalItems)
-		m.sortItems()
-		return
+func (m *pullRequestsListAllModel) applyQuery(s string) tea.Cmd {
+	if err := m.setQuery(s); err != nil {
+		m.query.fail(err)
+		return nil
 	}
-	items := make([]list.Item, 0)
-	for _, i := range m.originalItems {
-		if i.(pullRequestsListAllItem).status == m.statuses[m.statusIdx].name {
-			items = append(items, i)
-		}
+	m.list.ResetSelected()
+	return m.updateListItems()
+}
+
+func (m *pullRequestsListAllModel) currentView(name string) *savedView {
+	v := &savedView{
+		Name:  name,
+		Page:  pullRequestsListAllPageKey,
+		Query: m.query.applied,
+		Sort:  m.sorter.export(),
+	}
+	v.Filters = m.statusDialog.checkedNames()
+	return v
+}
+
+func (m *pullRequestsListAllModel) applyView(v *savedView) tea.Cmd {
+	m.statusDialog.setChecked(v.Filters)
+	m.sorter.restore(v.Sort)
+	var cmd tea.Cmd
+	if err := m.setQuery(v.Query); err != nil {
+		cmd = m.query.edit(v.Query)
+		m.query.fail(err)
+		m.updateListSize()
 	}
-	m.list.SetItems(items)
-	m.sortItems()
+	m.list.ResetSelected()
+	return tea.Batch(cmd, m.updateListItems())
 }
 
 func (m pullRequestsListAllModel) Init() tea.Cmd {
@@ -238,80 +386,171 @@ func (m pullRequestsListAllModel) openPullRequestPageInBrowser(item pullRequests
 	}
 }
 
+func (m pullRequestsListAllModel) capturingInput() bool {
+	return m.query.editing || m.list.FilterState() == list.Filtering ||
+		m.viewsDialog.opened || m.sortDialog.opened || m.statusDialog.opened
+}
+
 func (m pullRequestsListAllModel) Update(msg tea.Msg) (pullRequestsListAllModel, tea.Cmd) {
+	m, cmd := m.update(msg)
+	return m, tea.Batch(cmd, m.updatePreview())
+}
+
+func (m pullRequestsListAllModel) update(msg tea.Msg) (pullRequestsListAllModel, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if m.statusDialogOpened {
-			switch {
-			case key.Matches(msg, m.filterStatusDialogDelegateKeys.close):
-				m.statusDialogOpened = false
-			case key.Matches(msg, m.filterStatusDialogDelegateKeys.next):
-				m.list.ResetSelected()
-				m.updateStatusIdx(false)
-				m.filterItems()
-			case key.Matches(msg, m.filterStatusDialogDelegateKeys.prev):
+		if m.query.editing {
+			submitted, cmd := m.query.update(msg)
+			if submitted {
+				return m, m.applyQuery(m.query.value())
+			}
+			m.updateListSize()
+			return m, cmd
+		}
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+		if m.viewsDialog.opened {
+			switch action, cmd := m.viewsDialog.update(msg); action {
+			case savedViewsApplyAction:
+				return m, m.applyView(m.viewsDialog.selected())
+			case savedViewsSaveAction:
+				m.viewsDialog.add(m.currentView(m.viewsDialog.name()))
+				return m, nil
+			default:
+				return m, cmd
+			}
+		}
+		if m.sortDialog.opened || m.statusDialog.opened {
+			return m, m.updateDialogs(msg)
+		}
+		if m.preview.updateKey(msg, m.delegateKeys.preview) {
+			m.updateListSize()
+			return m, nil
+		}
+		if handled, sorted := m.table.updateKey(msg, m.delegateKeys.table); handled {
+			m.list.SetDelegate(m.table.listDelegate(m.delegate, m.delegateKeys.table))
+			m.updateListSize()
+			if sorted {
 				m.list.ResetSelected()
-				m.updateStatusIdx(true)
-				m.filterItems()
+				return m, m.updateListItems()
 			}
 			return m, nil
 		}
 		switch {
+		case key.Matches(msg, m.delegateKeys.sort):
+			m.sortDialog.open()
+			return m, nil
 		case key.Matches(msg, m.delegateKeys.stat):
-			m.statusDialogOpened = true
+			m.statusDialog.open(0)
+			return m, nil
+		case key.Matches(msg, m.delegateKeys.query):
+			cmd := m.query.open()
+			m.updateListSize()
+			return m, cmd
+		case key.Matches(msg, m.delegateKeys.views):
+			m.viewsDialog.open()
 			return m, nil
 		case key.Matches(msg, m.delegateKeys.open):
-			item := m.list.SelectedItem().(pullRequestsListAllItem)
+			item, ok := m.list.SelectedItem().(pullRequestsListAllItem)
+			if !ok {
+				return m, nil
+			}
 			return m, m.openPullRequestPageInBrowser(item)
 		case key.Matches(msg, m.delegateKeys.back):
 			if m.list.FilterState() != list.Filtering {
-				return m, goBackMenuPage
+				return m, goBack
 			}
 		case key.Matches(msg, m.delegateKeys.tog):
-			return m, togglePullRequestsList
+			return m, goBack
+		}
+	case tea.MouseMsg:
+		if m.viewsDialog.opened {
+			if action, _ := m.viewsDialog.update(msg); action == savedViewsApplyAction {
+				return m, m.applyView(m.viewsDialog.selected())
+			}
+			return m, nil
+		}
+		if m.sortDialog.opened || m.statusDialog.opened {
+			return m, m.updateDialogs(msg)
 		}
+		if m.table.clickHeader(msg, m.tableHeaderY()) {
+			m.list.ResetSelected()
+			return m, m.updateListItems()
+		}
+		if m.preview.updateMouse(msg) {
+			return m, nil
+		}
+		if updateListMouse(&m.list, m.table.listDelegate(m.delegate, m.delegateKeys.table), msg, m.itemsTop()) {
+			return m.update(keyPress(m.delegateKeys.open))
+		}
+		return m, nil
 	case togglePullRequestsListAllMsg:
 		m.list.ResetSelected()
 		m.updatePrs(msg.prs)
 		return m, nil
+	case previewLoadMsg, previewBodyMsg:
+		return m, m.preview.update(msg)
+	}
+	cmds := make([]tea.Cmd, 0)
+	if m.query.editing {
+		_, qCmd := m.query.update(msg)
+		cmds = append(cmds, qCmd)
+	}
+	if m.viewsDialog.naming {
+		_, vCmd := m.viewsDialog.update(msg)
+		cmds = append(cmds, vCmd)
 	}
 	m.list, cmd = m.list.Update(msg)
-	return m, cmd
+	cmds = append(cmds, cmd)
+	return m, tea.Batch(cmds...)
 }
 
-func (m pullRequestsListAllModel) View() string {
-	ret := titleView(m.breadcrumb()) + listView(m.list)
-	if m.statusDialogOpened {
-		return m.withStatusDialogView(ret)
+func (m *pullRequestsListAllModel) updateDialogs(msg tea.Msg) tea.Cmd {
+	if m.sortDialog.opened {
+		if m.sortDialog.update(msg) {
+			m.list.ResetSelected()
+			return m.updateListItems()
+		}
+		return nil
 	}
-	return ret
+	if m.statusDialog.update(msg) == selectDialogChanged {
+		m.list.ResetSelected()
+		return m.updateListItems()
+	}
+	return nil
 }
 
-func (m pullRequestsListAllModel) withStatusDialogView(base string) string {
-	title := repositoriesDialogTitleStyle.Render("Status")
-
-	ivs := make([]string, len(m.statuses))
-	for i, s := range m.statuses {
-		ivs[i] = m.statusKeySelectItemView(s)
-	}
-	body := strings.Join(ivs, "\n")
-	body = pullRequestsListAllDialogBodyStyle.Render(body)
+func (m pullRequestsListAllModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
+}
 
-	dialog := pullRequestsListAllDialogStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, body))
+func (m pullRequestsListAllModel) yankTargets() (*yankTarget, []*yankTarget) {
+	return listYankTargets(m.list, func(item pullRequestsListAllItem) *yankTarget {
+		return pullRequestYankTarget(item.repositoryFullName(), item.number, item.title, item.url)
+	})
+}
 
-	dw, dh := lipgloss.Size(dialog)
-	top := (m.height / 2) - (dh / 2)
-	left := (m.width / 2) - (dw / 2)
-	return kasane.OverlayString(base, dialog, top, left, kasane.WithPadding(m.width))
+func (m pullRequestsListAllModel) View() string {
+	ret := titleView(m.breadcrumb()) + m.query.view() + m.preview.splitView(m.listView())
+	if m.viewsDialog.opened {
+		return m.viewsDialog.view(ret)
+	}
+	if m.sortDialog.opened {
+		return m.sortDialog.view(ret)
+	}
+	if m.statusDialog.opened {
+		return m.statusDialog.view(ret)
+	}
+	return ret
 }
 
-func (m pullRequestsListAllModel) statusKeySelectItemView(status *pullRequestStatus) string {
-	if m.statuses[m.statusIdx].name == status.name {
-		return pullRequestsListAllDialogSelectedStyle.Render(fmt.Sprintf("> %s (%d)", status.name, status.count))
-	} else {
-		return pullRequestsListAllDialogNotSelectedStyle.Render(fmt.Sprintf("  %s (%d)", status.name, status.count))
+func (m pullRequestsListAllModel) listView() string {
+	if m.table.enabled {
+		return tableListView(m.list, m.table)
 	}
+	return listView(m.list)
 }
 
 func (m pullRequestsListAllModel) breadcrumb() []string {
@@ -8,31 +8,42 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/query"
 	"github.com/muesli/reflow/truncate"
 )
 
 type pullRequestsListAllItem struct {
-	owner      string
-	repository string
-	createdAt  time.Time
-	closedAt   time.Time
+	owner           string
+	repository      string
+	repositoryStars int
+	createdAt       time.Time
+	closedAt        time.Time
+	pullRequest     *query.PullRequest
 	pullRequestsListItem
 }
 
-func (i pullRequestsListAllItem) styledRepo(selected bool) string {
-	name := fmt.Sprintf("%s/%s", i.owner, i.repository)
+func (i pullRequestsListAllItem) repositoryFullName() string {
+	return fmt.Sprintf("%s/%s", i.owner, i.repository)
+}
+
+func (i pullRequestsListAllItem) styledRepo(selected bool, matches []int) string {
+	name := i.repositoryFullName()
 	if selected {
-		name = listSelectedTitleColorStyle.Render(name)
+		name = highlightMatches(name, matches, listSelectedTitleColorStyle)
 	} else {
-		name = listNormalTitleColorStyle.Render(name)
+		name = highlightMatches(name, matches, listNormalTitleColorStyle)
 	}
 	return name
 }
 
 var _ list.Item = (*pullRequestsListAllItem)(nil)
 
+func (i pullRequestsListAllItem) filterValue() filterValue {
+	return filterValue{i.repositoryFullName(), i.title}
+}
+
 func (i pullRequestsListAllItem) FilterValue() string {
-	return i.title
+	return i.filterValue().String()
 }
 
 type pullRequestsListAllDelegate struct {
@@ -47,7 +58,7 @@ func newPullRequestsListAllDelegate(delegateKeys pullRequestsListAllDelegateKeyM
 		return []key.Binding{delegateKeys.back, delegateKeys.tog}
 	}
 	fullHelpFunc := func() [][]key.Binding {
-		return [][]key.Binding{{delegateKeys.stat, delegateKeys.open, delegateKeys.back, delegateKeys.tog}}
+		return [][]key.Binding{{delegateKeys.sort, delegateKeys.stat, delegateKeys.query, delegateKeys.views, delegateKeys.open, delegateKeys.table.toggle, delegateKeys.preview.toggle, delegateKeys.preview.down, delegateKeys.back, delegateKeys.tog}}
 	}
 	return pullRequestsListAllDelegate{
 		shortHelpFunc: shortHelpFunc,
@@ -71,8 +82,10 @@ func (d pullRequestsListAllDelegate) Render(w io.Writer, m list.Model, index int
 	selected := index == m.Index()
 
 	i := item.(pullRequestsListAllItem)
-	repo := i.styledRepo(selected)
-	title := i.styledTitle(selected)
+	fv := i.filterValue()
+	matches := listItemMatches(m, index)
+	repo := i.styledRepo(selected, fv.matchesOf(0, matches))
+	title := i.styledTitle(selected, fv.matchesOf(1, matches))
 	desc := i.styledDesc(selected)
 
 	if m.Width() > 0 {
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func TestPullRequestsListAllOpenFiltered(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	prs := &gh.UserPullRequests{
+		TotalCount: 1,
+		Owners: []*gh.UserPullRequestsOwner{
+			{
+				Name: "bar",
+				Repositories: []*gh.UserPullRequestsRepository{
+					{
+						Name: "foo",
+						PullRequests: []*gh.UserPullRequestsPullRequest{
+							{Title: "fix", State: "MERGED", Number: 1, Additions: 10, Url: "https://github.com/bar/foo/pull/1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		query    string
+		items    int
+		openable bool
+	}{
+		{
+			query:    "",
+			items:    1,
+			openable: true,
+		},
+		{
+			query:    "status:merged",
+			items:    1,
+			openable: true,
+		},
+		{
+			query:    "additions:>100",
+			items:    0,
+			openable: false,
+		},
+	}
+	for _, test := range tests {
+		m := newPullRequestsListAllModel(nil)
+		m.SetSize(80, 24)
+		m.updatePrs(prs)
+		m.applyQuery(test.query)
+
+		if got := len(m.list.VisibleItems()); notEqual(got, test.items) {
+			t.Errorf("query: %s, items got: %d, want: %d", test.query, got, test.items)
+		}
+		var cmd tea.Cmd
+		*m, cmd = m.Update(keyPress(m.delegateKeys.open))
+		if got := cmd != nil; notEqual(got, test.openable) {
+			t.Errorf("query: %s, openable got: %v, want: %v", test.query, got, test.openable)
+		}
+	}
+}
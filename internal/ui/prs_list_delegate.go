@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 	"github.com/muesli/reflow/truncate"
 )
 
@@ -17,21 +18,21 @@ var (
 
 	statusOpenStyle = statusStyleBase.Copy().
 			Bold(true).
-			Foreground(lipgloss.Color("34"))
+			Foreground(theme.Open.Color())
 
 	statusMergedStyle = statusStyleBase.Copy().
 				Bold(true).
-				Foreground(lipgloss.Color("98"))
+				Foreground(theme.Merged.Color())
 
 	statusClosedStyle = statusStyleBase.Copy().
 				Bold(true).
-				Foreground(lipgloss.Color("203"))
+				Foreground(theme.Closed.Color())
 
 	additionsStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("34"))
+			Foreground(theme.Added.Color())
 
 	deletionsStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("203"))
+			Foreground(theme.Deleted.Color())
 )
 
 type pullRequestsListItem struct {
@@ -46,12 +47,12 @@ type pullRequestsListItem struct {
 	url       string
 }
 
-func (i pullRequestsListItem) styledTitle(selected bool) string {
+func (i pullRequestsListItem) styledTitle(selected bool, matches []int) string {
 	var title, status string
 	if selected {
-		title = listSelectedTitleColorStyle.Render(i.title)
+		title = highlightMatches(i.title, matches, listSelectedTitleColorStyle)
 	} else {
-		title = listNormalTitleColorStyle.Render(i.title)
+		title = highlightMatches(i.title, matches, listNormalTitleColorStyle)
 	}
 	switch i.status {
 	case "OPEN":
@@ -112,8 +113,12 @@ func (i pullRequestsListItem) styledModifications() string {
 
 var _ list.Item = (*pullRequestsListItem)(nil)
 
+func (i pullRequestsListItem) filterValue() filterValue {
+	return filterValue{i.title}
+}
+
 func (i pullRequestsListItem) FilterValue() string {
-	return i.title
+	return i.filterValue().String()
 }
 
 type pullRequestsListDelegate struct {
@@ -128,7 +133,7 @@ func newPullRequestsListDelegate(delegateKeys pullRequestsListDelegateKeyMap) pu
 		return []key.Binding{delegateKeys.back}
 	}
 	fullHelpFunc := func() [][]key.Binding {
-		return [][]key.Binding{{delegateKeys.open, delegateKeys.back}}
+		return [][]key.Binding{{delegateKeys.open, delegateKeys.preview.toggle, delegateKeys.preview.down, delegateKeys.back}}
 	}
 	return pullRequestsListDelegate{
 		shortHelpFunc: shortHelpFunc,
@@ -152,7 +157,8 @@ func (d pullRequestsListDelegate) Render(w io.Writer, m list.Model, index int, i
 	selected := index == m.Index()
 
 	i := item.(pullRequestsListItem)
-	title := i.styledTitle(selected)
+	matches := i.filterValue().matchesOf(0, listItemMatches(m, index))
+	title := i.styledTitle(selected, matches)
 	desc := i.styledDesc(selected)
 
 	if m.Width() > 0 {
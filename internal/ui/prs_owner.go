@@ -13,6 +13,7 @@ type pullRequestsOwnerModel struct {
 	prs *gh.UserPullRequests
 
 	list         list.Model
+	delegate     list.DefaultDelegate
 	delegateKeys pullRequestsOwnerDelegateKeyMap
 
 	selectedUser  string
@@ -20,9 +21,10 @@ type pullRequestsOwnerModel struct {
 }
 
 type pullRequestsOwnerItem struct {
-	name       string
-	reposCount int
-	prsCount   int
+	name         string
+	organization bool
+	reposCount   int
+	prsCount     int
 }
 
 var _ list.DefaultItem = (*pullRequestsOwnerItem)(nil)
@@ -51,30 +53,28 @@ func (i pullRequestsOwnerItem) FilterValue() string {
 }
 
 type pullRequestsOwnerDelegateKeyMap struct {
-	sel  key.Binding
-	back key.Binding
-	tog  key.Binding
-	quit key.Binding
+	sel   key.Binding
+	back  key.Binding
+	tog   key.Binding
+	owner key.Binding
+	quit  key.Binding
 }
 
+var pullRequestsOwnerKeys = registerKeyScope("prs-owner",
+	newKeyAction("select", "enter", "select", "enter"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("toggle", "tab", "toggle", "tab"),
+	newKeyAction("owner", "o", "show owner", "o"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+).with("list")
+
 func newPullRequestsOwnerDelegateKeyMap() pullRequestsOwnerDelegateKeyMap {
 	return pullRequestsOwnerDelegateKeyMap{
-		sel: key.NewBinding(
-			key.WithKeys("enter"),
-			key.WithHelp("enter", "select"),
-		),
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		tog: key.NewBinding(
-			key.WithKeys("tab"),
-			key.WithHelp("tab", "toggle"),
-		),
-		quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		sel:   pullRequestsOwnerKeys.binding("select"),
+		back:  pullRequestsOwnerKeys.binding("back"),
+		tog:   pullRequestsOwnerKeys.binding("toggle"),
+		owner: pullRequestsOwnerKeys.binding("owner"),
+		quit:  pullRequestsOwnerKeys.binding("quit"),
 	}
 }
 
@@ -84,22 +84,23 @@ func newPullRequestsOwnerModel() *pullRequestsOwnerModel {
 
 	delegateKeys := newPullRequestsOwnerDelegateKeyMap()
 	delegate.ShortHelpFunc = func() []key.Binding {
-		return []key.Binding{delegateKeys.sel, delegateKeys.back, delegateKeys.tog}
+		return []key.Binding{delegateKeys.sel, delegateKeys.back, delegateKeys.tog, delegateKeys.owner}
 	}
 	delegate.FullHelpFunc = func() [][]key.Binding {
-		return [][]key.Binding{{delegateKeys.sel, delegateKeys.back, delegateKeys.tog}}
+		return [][]key.Binding{{delegateKeys.sel, delegateKeys.back, delegateKeys.tog, delegateKeys.owner}}
 	}
 
-	delegate.Styles.SelectedTitle = delegate.Styles.SelectedTitle.Copy().Foreground(selectedColor1).BorderForeground(selectedColor2)
-	delegate.Styles.SelectedDesc = delegate.Styles.SelectedDesc.Copy().Foreground(selectedColor2).BorderForeground(selectedColor2)
+	delegate.Styles = newListItemStyles()
 	l := list.New(items, delegate, 0, 0)
 	l.Title = appTitle
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 
 	return &pullRequestsOwnerModel{
 		list:         l,
+		delegate:     delegate,
 		delegateKeys: delegateKeys,
 	}
 }
@@ -124,9 +125,10 @@ func (m *pullRequestsOwnerModel) updatePrs(prs *gh.UserPullRequests) {
 			prsCount += len(repo.PullRequests)
 		}
 		item := pullRequestsOwnerItem{
-			name:       owner.Name,
-			reposCount: len(repos),
-			prsCount:   prsCount,
+			name:         owner.Name,
+			organization: owner.Organization,
+			reposCount:   len(repos),
+			prsCount:     prsCount,
 		}
 		items[i] = item
 	}
@@ -141,28 +143,58 @@ func (m pullRequestsOwnerModel) selectPullRequestsOwner(name string) tea.Cmd {
 	return func() tea.Msg {
 		for _, owner := range m.prs.Owners {
 			if owner.Name == name {
-				return selectPullRequestsOwnerMsg{owner}
+				return selectPullRequestsOwnerMsg{owner, nil}
 			}
 		}
 		return pullRequestsErrorMsg{nil, "failed to get owner"}
 	}
 }
 
+func (m *pullRequestsOwnerModel) focus(name string) {
+	for i, item := range m.list.Items() {
+		if item.(pullRequestsOwnerItem).name == name {
+			m.list.Select(i)
+			return
+		}
+	}
+}
+
+func (m pullRequestsOwnerModel) capturingInput() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
 func (m pullRequestsOwnerModel) Update(msg tea.Msg) (pullRequestsOwnerModel, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
 		switch {
 		case key.Matches(msg, m.delegateKeys.sel):
-			item := m.list.SelectedItem().(pullRequestsOwnerItem)
+			item, ok := m.list.SelectedItem().(pullRequestsOwnerItem)
+			if !ok {
+				return m, nil
+			}
 			return m, m.selectPullRequestsOwner(item.name)
 		case key.Matches(msg, m.delegateKeys.back):
 			if m.list.FilterState() != list.Filtering {
-				return m, goBackMenuPage
+				return m, goBack
 			}
 		case key.Matches(msg, m.delegateKeys.tog):
 			return m, togglePullRequestsListAll(m.prs)
+		case key.Matches(msg, m.delegateKeys.owner):
+			item, ok := m.list.SelectedItem().(pullRequestsOwnerItem)
+			if !ok {
+				return m, nil
+			}
+			return m, ownerSelected(item.name, item.organization)
+		}
+	case tea.MouseMsg:
+		if updateListMouse(&m.list, m.delegate, msg, m.itemsTop()) {
+			return m.Update(keyPress(m.delegateKeys.sel))
 		}
+		return m, nil
 	case pullRequestsSuccessMsg:
 		m.list.ResetSelected()
 		m.updatePrs(msg.prs)
@@ -172,6 +204,21 @@ func (m pullRequestsOwnerModel) Update(msg tea.Msg) (pullRequestsOwnerModel, tea
 	return m, cmd
 }
 
+func (m pullRequestsOwnerModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
+}
+
+func (m pullRequestsOwnerModel) yankTargets() (*yankTarget, []*yankTarget) {
+	return listYankTargets(m.list, func(item pullRequestsOwnerItem) *yankTarget {
+		return userYankTarget(item.name)
+	})
+}
+
+func (m pullRequestsOwnerModel) itemsTop() int {
+	// below the title
+	return 2 + listItemsOffset(m.list)
+}
+
 func (m pullRequestsOwnerModel) View() string {
 	return titleView(m.breadcrumb()) + listView(m.list)
 }
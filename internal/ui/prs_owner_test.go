@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func TestPullRequestsOwnerShowOwner(t *testing.T) {
+	tests := []struct {
+		owners []*gh.UserPullRequestsOwner
+		want   *userSelectMsg
+	}{
+		{
+			owners: []*gh.UserPullRequestsOwner{},
+			want:   nil,
+		},
+		{
+			owners: []*gh.UserPullRequestsOwner{{Name: "alice"}},
+			want:   &userSelectMsg{id: "alice", organization: false},
+		},
+		{
+			owners: []*gh.UserPullRequestsOwner{{Name: "acme", Organization: true}},
+			want:   &userSelectMsg{id: "acme", organization: true},
+		},
+	}
+	for _, test := range tests {
+		m := newPullRequestsOwnerModel()
+		m.SetSize(80, 24)
+		m.updatePrs(&gh.UserPullRequests{Owners: test.owners})
+
+		var cmd tea.Cmd
+		*m, cmd = m.Update(keyPress(m.delegateKeys.owner))
+		var got *userSelectMsg
+		if cmd != nil {
+			msg := cmd().(userSelectMsg)
+			got = &msg
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("owners: %d, got: %v, want: %v", len(test.owners), got, test.want)
+		}
+	}
+}
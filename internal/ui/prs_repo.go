@@ -11,38 +11,38 @@ type pullRequestsRepositoryModel struct {
 	repos []*gh.UserPullRequestsRepository
 
 	list         list.Model
+	delegate     pullRequestsRepositoryDelegate
 	delegateKeys pullRequestsRepositoryDelegateKeyMap
 
-	selectedUser  string
-	selectedOwner string
-	width, height int
+	selectedUser              string
+	selectedOwner             string
+	selectedOwnerOrganization bool
+	width, height             int
 }
 
 type pullRequestsRepositoryDelegateKeyMap struct {
-	open key.Binding
-	sel  key.Binding
-	back key.Binding
-	quit key.Binding
+	open  key.Binding
+	sel   key.Binding
+	back  key.Binding
+	owner key.Binding
+	quit  key.Binding
 }
 
+var pullRequestsRepositoryKeys = registerKeyScope("prs-repo",
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("select", "enter", "select", "enter"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("owner", "o", "show owner", "o"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+).with("list")
+
 func newPullRequestsRepositoryDelegateKeyMap() pullRequestsRepositoryDelegateKeyMap {
 	return pullRequestsRepositoryDelegateKeyMap{
-		open: key.NewBinding(
-			key.WithKeys("x"),
-			key.WithHelp("x", "open in browser"),
-		),
-		sel: key.NewBinding(
-			key.WithKeys("enter"),
-			key.WithHelp("enter", "select"),
-		),
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		open:  pullRequestsRepositoryKeys.binding("open"),
+		sel:   pullRequestsRepositoryKeys.binding("select"),
+		back:  pullRequestsRepositoryKeys.binding("back"),
+		owner: pullRequestsRepositoryKeys.binding("owner"),
+		quit:  pullRequestsRepositoryKeys.binding("quit"),
 	}
 }
 
@@ -53,11 +53,13 @@ func newPullRequestsRepositoryModel() *pullRequestsRepositoryModel {
 	l := list.New(nil, delegate, 0, 0)
 	l.KeyMap.Quit = delegateKeys.quit
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 
 	return &pullRequestsRepositoryModel{
 		list:         l,
+		delegate:     delegate,
 		delegateKeys: delegateKeys,
 	}
 }
@@ -72,8 +74,9 @@ func (m *pullRequestsRepositoryModel) SetUser(id string) {
 	m.selectedUser = id
 }
 
-func (m *pullRequestsRepositoryModel) setOwner(name string) {
-	m.selectedOwner = name
+func (m *pullRequestsRepositoryModel) setOwner(owner *gh.UserPullRequestsOwner) {
+	m.selectedOwner = owner.Name
+	m.selectedOwnerOrganization = owner.Organization
 }
 
 func (m *pullRequestsRepositoryModel) updateRepos(repos []*gh.UserPullRequestsRepository) {
@@ -101,13 +104,33 @@ func (m pullRequestsRepositoryModel) selectPullRequestsRepository(name string) t
 	return func() tea.Msg {
 		for _, repo := range m.repos {
 			if repo.Name == name {
-				return selectPullRequestsRepositoryMsg{repo, m.selectedOwner}
+				return selectPullRequestsRepositoryMsg{repo, m.selectedOwner, 0}
 			}
 		}
 		return pullRequestsErrorMsg{nil, "failed to get repository"}
 	}
 }
 
+func (m *pullRequestsRepositoryModel) focus(name string) {
+	for i, item := range m.list.Items() {
+		if item.(*pullRequestsRepositoryItem).name == name {
+			m.list.Select(i)
+			return
+		}
+	}
+}
+
+// focusPullRequest opens the page of the repository and selects the pull request.
+func (m *pullRequestsRepositoryModel) focusPullRequest(focus *pullRequestsFocus) tea.Cmd {
+	for _, repo := range m.repos {
+		if repo.Name == focus.repository {
+			m.focus(repo.Name)
+			return func() tea.Msg { return selectPullRequestsRepositoryMsg{repo, m.selectedOwner, focus.number} }
+		}
+	}
+	return nil
+}
+
 func (m pullRequestsRepositoryModel) openRepositoryPageInBrowser(item *pullRequestsRepositoryItem) tea.Cmd {
 	return func() tea.Msg {
 		if err := openBrowser(item.url); err != nil {
@@ -117,32 +140,70 @@ func (m pullRequestsRepositoryModel) openRepositoryPageInBrowser(item *pullReque
 	}
 }
 
+func (m pullRequestsRepositoryModel) capturingInput() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
 func (m pullRequestsRepositoryModel) Update(msg tea.Msg) (pullRequestsRepositoryModel, tea.Cmd) {
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
 		switch {
 		case key.Matches(msg, m.delegateKeys.open):
-			item := m.list.SelectedItem().(*pullRequestsRepositoryItem)
+			item, ok := m.list.SelectedItem().(*pullRequestsRepositoryItem)
+			if !ok {
+				return m, nil
+			}
 			return m, m.openRepositoryPageInBrowser(item)
 		case key.Matches(msg, m.delegateKeys.sel):
-			item := m.list.SelectedItem().(*pullRequestsRepositoryItem)
+			item, ok := m.list.SelectedItem().(*pullRequestsRepositoryItem)
+			if !ok {
+				return m, nil
+			}
 			return m, m.selectPullRequestsRepository(item.name)
 		case key.Matches(msg, m.delegateKeys.back):
 			if m.list.FilterState() != list.Filtering {
-				return m, goBackPullRequestsOwnerPage
+				return m, goBack
 			}
+		case key.Matches(msg, m.delegateKeys.owner):
+			return m, ownerSelected(m.selectedOwner, m.selectedOwnerOrganization)
 		}
+	case tea.MouseMsg:
+		if updateListMouse(&m.list, m.delegate, msg, m.itemsTop()) {
+			return m.Update(keyPress(m.delegateKeys.sel))
+		}
+		return m, nil
 	case selectPullRequestsOwnerMsg:
 		m.list.ResetSelected()
 		m.updateRepos(msg.owner.Repositories)
-		m.setOwner(msg.owner.Name)
+		m.setOwner(msg.owner)
+		if msg.focus != nil {
+			return m, m.focusPullRequest(msg.focus)
+		}
 		return m, nil
 	}
 	m.list, cmd = m.list.Update(msg)
 	return m, cmd
 }
 
+func (m pullRequestsRepositoryModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
+}
+
+func (m pullRequestsRepositoryModel) yankTargets() (*yankTarget, []*yankTarget) {
+	return listYankTargets(m.list, func(item *pullRequestsRepositoryItem) *yankTarget {
+		return repositoryYankTarget(m.selectedOwner+"/"+item.name, item.url)
+	})
+}
+
+func (m pullRequestsRepositoryModel) itemsTop() int {
+	// below the title
+	return 2 + listItemsOffset(m.list)
+}
+
 func (m pullRequestsRepositoryModel) View() string {
 	return titleView(m.breadcrumb()) + listView(m.list)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 	"github.com/muesli/reflow/truncate"
 )
 
@@ -22,8 +23,12 @@ type pullRequestsRepositoryItem struct {
 
 var _ list.Item = (*pullRequestsRepositoryItem)(nil)
 
+func (i pullRequestsRepositoryItem) filterValue() filterValue {
+	return filterValue{i.name, i.description, i.langName}
+}
+
 func (i pullRequestsRepositoryItem) FilterValue() string {
-	return i.name
+	return i.filterValue().String()
 }
 
 type pullRequestsRepositoryDelegate struct {
@@ -42,15 +47,13 @@ type pullRequestsRepositoryDelegate struct {
 var _ list.ItemDelegate = (*pullRequestsRepositoryDelegate)(nil)
 
 func newPullRequestsRepositoryDelegate(delegateKeys pullRequestsRepositoryDelegateKeyMap) pullRequestsRepositoryDelegate {
-	styles := list.NewDefaultItemStyles()
-	styles.SelectedTitle = styles.SelectedTitle.Copy().Foreground(selectedColor1).BorderForeground(selectedColor2)
-	styles.SelectedDesc = styles.SelectedDesc.Copy().Foreground(selectedColor2).BorderForeground(selectedColor2)
+	styles := newListItemStyles()
 
 	shortHelpFunc := func() []key.Binding {
-		return []key.Binding{delegateKeys.sel, delegateKeys.back}
+		return []key.Binding{delegateKeys.sel, delegateKeys.back, delegateKeys.owner}
 	}
 	fullHelpFunc := func() [][]key.Binding {
-		return [][]key.Binding{{delegateKeys.open, delegateKeys.sel, delegateKeys.back}}
+		return [][]key.Binding{{delegateKeys.open, delegateKeys.sel, delegateKeys.back, delegateKeys.owner}}
 	}
 
 	normalDescWithoutPadding := styles.NormalDesc.Copy().UnsetPadding()
@@ -95,6 +98,7 @@ func (d pullRequestsRepositoryDelegate) Render(w io.Writer, m list.Model, index
 	if desc == "" {
 		desc = "-"
 	}
+	lang := i.langName
 
 	prs := fmt.Sprintf("%d pull request", i.prsCount)
 	if i.prsCount > 1 {
@@ -105,8 +109,8 @@ func (d pullRequestsRepositoryDelegate) Render(w io.Writer, m list.Model, index
 	// U+26AB will be displayed as emoji
 	// U+2B24 is too large
 	detailsLangColor := "◍ "
-	detailsLangColor = lipgloss.NewStyle().Foreground(lipgloss.Color(i.langColor)).Render(detailsLangColor)
-	details := fmt.Sprintf("%s     %s", i.langName, prs)
+	detailsLangColor = lipgloss.NewStyle().Foreground(theme.Language(i.langColor)).Render(detailsLangColor)
+	details := fmt.Sprintf("     %s", prs)
 
 	if m.Width() > 0 {
 		textwidth := uint(m.Width() - s.NormalTitle.GetPaddingLeft() - s.NormalTitle.GetPaddingRight())
@@ -124,32 +128,32 @@ func (d pullRequestsRepositoryDelegate) Render(w io.Writer, m list.Model, index
 	if isFiltered && index < len(m.VisibleItems()) {
 		matchedRunes = m.MatchesForItem(index)
 	}
+	fv := i.filterValue()
+	nameMatches := fv.matchesOf(0, matchedRunes)
+	descMatches := fv.matchesOf(1, matchedRunes)
+	langMatches := fv.matchesOf(2, matchedRunes)
 
 	if emptyFilter {
 		name = s.DimmedTitle.Render(name)
 		desc = s.DimmedDesc.Render(desc)
-		details = d.dimmedDescWithoutPadding.Render(details)
+		details = d.dimmedDescWithoutPadding.Render(lang + details)
 		details = d.dimmedDescOnlyPadding.Render(detailsLangColor + details)
 	} else if isSelected && m.FilterState() != list.Filtering {
-		if isFiltered {
-			unmatched := s.SelectedTitle.Inline(true)
-			matched := unmatched.Copy().Inherit(s.FilterMatch)
-			name = lipgloss.StyleRunes(name, matchedRunes, matched, unmatched)
-		}
-		name = s.SelectedTitle.Render(name)
-		desc = s.SelectedDesc.Render(desc)
-		details = d.selectedDescWithoutPadding.Render(details)
+		name = highlightMatches(name, nameMatches, s.SelectedTitle.Copy().Inline(true))
+		desc = highlightMatches(desc, descMatches, s.SelectedDesc.Copy().Inline(true))
+		lang = highlightMatches(lang, langMatches, d.selectedDescWithoutPadding)
+		details = lang + d.selectedDescWithoutPadding.Render(details)
 		details = d.selectedDescOnlyPadding.Render(detailsLangColor + details)
+		name = d.selectedDescOnlyPadding.Render(name)
+		desc = d.selectedDescOnlyPadding.Render(desc)
 	} else {
-		if isFiltered {
-			unmatched := s.NormalTitle.Inline(true)
-			matched := unmatched.Copy().Inherit(s.FilterMatch)
-			name = lipgloss.StyleRunes(name, matchedRunes, matched, unmatched)
-		}
-		name = s.NormalTitle.Render(name)
-		desc = s.NormalDesc.Render(desc)
-		details = d.normalDescWithoutPadding.Render(details)
+		name = highlightMatches(name, nameMatches, s.NormalTitle.Copy().Inline(true))
+		desc = highlightMatches(desc, descMatches, s.NormalDesc.Copy().Inline(true))
+		lang = highlightMatches(lang, langMatches, d.normalDescWithoutPadding)
+		details = lang + d.normalDescWithoutPadding.Render(details)
 		details = d.normalDescOnlyPadding.Render(detailsLangColor + details)
+		name = d.normalDescOnlyPadding.Render(name)
+		desc = d.normalDescOnlyPadding.Render(desc)
 	}
 
 	fmt.Fprintf(w, "%s\n%s\n%s", name, desc, details)
@@ -0,0 +1,302 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/stats"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+var (
+	pullRequestStatsErrorStyle = lipgloss.NewStyle().
+					Padding(2, 0, 0, 2).
+					Foreground(theme.Error.Color())
+
+	pullRequestStatsViewportStyle = lipgloss.NewStyle().
+					Padding(1, 0, 0, 2)
+
+	statsSectionTitleStyle = lipgloss.NewStyle().
+				Bold(true)
+
+	statsSectionStyle = lipgloss.NewStyle().
+				Padding(0, 0, 1, 2)
+
+	statsItemNameStyle = lipgloss.NewStyle().
+				Foreground(theme.Dimmed.Color()).
+				Width(16)
+)
+
+const (
+	// the number of owners and repositories shown in the rankings
+	statsTopCount = 10
+)
+
+type statsKeyMap struct {
+	Back key.Binding
+	Quit key.Binding
+}
+
+func (k statsKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Back,
+		k.Quit,
+	}
+}
+
+func (k statsKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			k.Back,
+		},
+		{
+			k.Quit,
+		},
+	}
+}
+
+type pullRequestStatsModel struct {
+	client *gh.GitHubClient
+
+	keys     statsKeyMap
+	viewport viewport.Model
+	help     help.Model
+	stats    *stats.PullRequests
+	spinner  *spinner.Model
+
+	errorMsg      *pullRequestStatsErrorMsg
+	loading       bool
+	selectedUser  string
+	width, height int
+}
+
+var pullRequestStatsKeys = registerKeyScope("pr-stats",
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+func newPullRequestStatsModel(client *gh.GitHubClient, s *spinner.Model) pullRequestStatsModel {
+	keys := statsKeyMap{
+		Back: pullRequestStatsKeys.binding("back"),
+		Quit: pullRequestStatsKeys.binding("quit"),
+	}
+	return pullRequestStatsModel{
+		client:   client,
+		keys:     keys,
+		viewport: viewport.New(0, 0),
+		help:     help.New(),
+		spinner:  s,
+	}
+}
+
+func init() {
+	registerPage(func(ctx *pageContext, _ selectPullRequestStatsPageMsg) page {
+		m := newPullRequestStatsModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+}
+
+func (m *pullRequestStatsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	t, r, b, l := pullRequestStatsViewportStyle.GetPadding()
+	m.viewport.Width = width - r - l
+	m.viewport.Height = height - 4 - t - b
+	m.updateContent()
+}
+
+func (m *pullRequestStatsModel) SetUser(id string) {
+	m.selectedUser = id
+}
+
+func (m *pullRequestStatsModel) updateContent() {
+	if m.stats == nil {
+		return
+	}
+	m.viewport.SetContent(m.statsContentsView())
+}
+
+func (m pullRequestStatsModel) Init() tea.Cmd {
+	return nil
+}
+
+type pullRequestStatsSuccessMsg struct {
+	stats *stats.PullRequests
+}
+
+var _ tea.Msg = (*pullRequestStatsSuccessMsg)(nil)
+
+type pullRequestStatsErrorMsg struct {
+	e       error
+	summary string
+}
+
+var _ tea.Msg = (*pullRequestStatsErrorMsg)(nil)
+
+func (m pullRequestStatsModel) loadStats(id string) tea.Cmd {
+	return func() tea.Msg {
+		prs, err := m.client.QueryUserPullRequests(id)
+		if err != nil {
+			return pullRequestStatsErrorMsg{err, "failed to fetch pull requests"}
+		}
+		return pullRequestStatsSuccessMsg{stats.PullRequestStats(prs, time.Now())}
+	}
+}
+
+func (m pullRequestStatsModel) Update(msg tea.Msg) (pullRequestStatsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			return m, goBack
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case selectPullRequestStatsPageMsg:
+		m.loading = true
+		return m, m.loadStats(msg.id)
+	case pullRequestStatsSuccessMsg:
+		m.errorMsg = nil
+		m.loading = false
+		m.stats = msg.stats
+		m.updateContent()
+		m.viewport.GotoTop()
+		return m, nil
+	case pullRequestStatsErrorMsg:
+		m.errorMsg = &msg
+		m.loading = false
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m pullRequestStatsModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m pullRequestStatsModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
+	}
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	return m.statsView()
+}
+
+func (m pullRequestStatsModel) statsView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	vp := pullRequestStatsViewportStyle.Render(m.viewport.View())
+	ret += vp
+	height -= cn(vp)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m pullRequestStatsModel) statsContentsView() string {
+	s := m.stats
+	if s.Total == 0 {
+		return "No pull requests"
+	}
+	chartWidth := min(m.viewport.Width-2, 60)
+
+	summary := []string{
+		statsItemView("Pull Requests", fmt.Sprintf("%d (open %d / merged %d / closed %d)", s.Total, s.Open, s.Merged, s.Closed)),
+		statsItemView("Merge rate", fmt.Sprintf("%.1f%%", s.MergeRate()*100)),
+		statsItemView("Time to merge", statsMedianView(s.MedianTimeToMerge)),
+		statsItemView("Time to close", statsMedianView(s.MedianTimeToClose)),
+		statsItemView("Changes", additionsStyle.Render(fmt.Sprintf("+%d", s.Additions))+" "+deletionsStyle.Render(fmt.Sprintf("-%d", s.Deletions))),
+	}
+
+	ret := ""
+	ret += statsSectionView("Summary", strings.Join(summary, "\n"))
+	ret += statsSectionView("Per year", barChartView(s.PerYear, chartWidth))
+	ret += statsSectionView("Last 12 months", barChartView(s.PerMonth, chartWidth))
+	ret += statsSectionView("Top owners (merged)", barChartView(statsTop(s.TopOwners), chartWidth))
+	ret += statsSectionView("Top repositories (merged)", barChartView(statsTop(s.TopRepositories), chartWidth))
+	ret += statsSectionView("Languages", barChartView(s.Languages, chartWidth))
+	return ret
+}
+
+func statsSectionView(title, body string) string {
+	if body == "" {
+		body = "-"
+	}
+	return statsSectionTitleStyle.Render(title) + "\n" + statsSectionStyle.Render(body) + "\n"
+}
+
+func statsItemView(name, value string) string {
+	return statsItemNameStyle.Render(name) + value
+}
+
+func statsMedianView(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+	return formatSpan(d) + " (median)"
+}
+
+func statsTop(counts []stats.Count) []stats.Count {
+	return counts[:min(len(counts), statsTopCount)]
+}
+
+func (m pullRequestStatsModel) errorView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	errorText := pullRequestStatsErrorStyle.Render("ERROR: " + m.errorMsg.summary)
+	ret += errorText
+	height -= cn(errorText)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m pullRequestStatsModel) breadcrumb() []string {
+	return []string{m.selectedUser, "PR Stats"}
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+var (
+	queryPromptStyle = lipgloss.NewStyle().
+				Padding(0, 0, 0, 2)
+
+	queryAppliedStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color())
+
+	queryErrorStyle = lipgloss.NewStyle().
+			Foreground(theme.Error.Color())
+)
+
+type queryPromptKeyMap struct {
+	apply  key.Binding
+	cancel key.Binding
+}
+
+var queryPromptKeys = registerKeyScope("query-prompt",
+	newKeyAction("apply", "enter", "apply query", "enter"),
+	newKeyAction("cancel", "esc", "cancel", "esc"),
+)
+
+func newQueryPromptKeyMap() queryPromptKeyMap {
+	return queryPromptKeyMap{
+		apply:  queryPromptKeys.binding("apply"),
+		cancel: queryPromptKeys.binding("cancel"),
+	}
+}
+
+// queryPrompt is a single line input for the query language (see internal/query).
+type queryPrompt struct {
+	input   textinput.Model
+	keys    queryPromptKeyMap
+	editing bool
+	applied string
+	err     error
+}
+
+func newQueryPrompt() *queryPrompt {
+	input := textinput.New()
+	input.Prompt = "Query: "
+	input.Placeholder = "e.g. stars:>50 lang:Go -archived"
+	return &queryPrompt{
+		input: input,
+		keys:  newQueryPromptKeyMap(),
+	}
+}
+
+func (p *queryPrompt) open() tea.Cmd {
+	return p.edit(p.applied)
+}
+
+// edit opens the prompt with the given query.
+func (p *queryPrompt) edit(q string) tea.Cmd {
+	p.editing = true
+	p.err = nil
+	p.input.SetValue(q)
+	p.input.CursorEnd()
+	return p.input.Focus()
+}
+
+func (p *queryPrompt) close() {
+	p.editing = false
+	p.err = nil
+	p.input.Blur()
+}
+
+func (p *queryPrompt) visible() bool {
+	return p.editing || p.applied != ""
+}
+
+func (p *queryPrompt) height() int {
+	if p.visible() {
+		return 1
+	}
+	return 0
+}
+
+func (p *queryPrompt) value() string {
+	return p.input.Value()
+}
+
+// apply marks the query as applied and closes the prompt.
+func (p *queryPrompt) apply(q string) {
+	p.applied = q
+	p.close()
+}
+
+// fail shows the error and keeps the prompt open.
+func (p *queryPrompt) fail(err error) {
+	p.err = err
+}
+
+// update handles the message while editing and reports whether the query has been submitted.
+func (p *queryPrompt) update(msg tea.Msg) (bool, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, p.keys.apply):
+			return true, nil
+		case key.Matches(msg, p.keys.cancel):
+			p.close()
+			return false, nil
+		}
+		p.err = nil
+	}
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return false, cmd
+}
+
+func (p *queryPrompt) view() string {
+	if p.editing {
+		v := p.input.View()
+		if p.err != nil {
+			v += "  " + queryErrorStyle.Render(p.err.Error())
+		}
+		return "\n" + queryPromptStyle.Render(v)
+	}
+	if p.applied != "" {
+		return "\n" + queryPromptStyle.Render(queryAppliedStyle.Render(p.input.Prompt)+p.applied)
+	}
+	return ""
+}
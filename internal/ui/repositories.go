@@ -2,7 +2,6 @@ package ui
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -11,46 +10,118 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lusingander/ghcv-cli/internal/gh"
-	"github.com/lusingander/kasane"
+	"github.com/lusingander/ghcv-cli/internal/query"
+	"github.com/lusingander/ghcv-cli/internal/stats"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 var (
 	repositoriesErrorStyle = lipgloss.NewStyle().
 				Padding(2, 0, 0, 2).
-				Foreground(lipgloss.Color("161"))
+				Foreground(theme.Error.Color())
 
 	dialogTitleStyle = lipgloss.NewStyle().
 				Align(lipgloss.Center).
 				Border(lipgloss.NormalBorder(), false, false, true, false).
-				BorderForeground(lipgloss.Color("240"))
-
-	repositoriesDialogTitleStyle = dialogTitleStyle.Copy().
-					Width(30)
-
-	repositoriesDialogBodyStyle = lipgloss.NewStyle().
-					Padding(0, 2)
-
-	reposirotiesDialogStyle = lipgloss.NewStyle().
-				BorderStyle(lipgloss.RoundedBorder())
-
-	repositoriesDialogSelectedStyle = lipgloss.NewStyle().
-					Foreground(selectedColor1)
-
-	repositoriesDialogNotSelectedStyle = lipgloss.NewStyle()
+				BorderForeground(theme.Muted.Color())
 )
 
-type sortType int
-
 const (
-	sortByStarDesc sortType = iota
-	sortByStarAsc
-	sortByUpdatedDesc
-	sortByUpdatedAsc
+	// identifies the page in the remembered sort orders and the saved views
+	repositoriesPageKey = "repositories"
 )
 
-type repositoeisLang struct {
-	name  string
-	count int
+func repositoriesSortFields() []*sortField {
+	repo := func(i list.Item) *repositoryItem {
+		return i.(*repositoryItem)
+	}
+	return []*sortField{
+		{
+			name:        "Stars",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareInt(repo(a).stars, repo(b).stars) },
+		},
+		{
+			name:        "Forks",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareInt(repo(a).forks, repo(b).forks) },
+		},
+		{
+			name:        "Watchers",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareInt(repo(a).watchers, repo(b).watchers) },
+		},
+		{
+			name:        "Open Issues",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareInt(repo(a).openIssues, repo(b).openIssues) },
+		},
+		{
+			name:        "Name",
+			defaultDesc: false,
+			compare:     func(a, b list.Item) int { return compareString(repo(a).title, repo(b).title) },
+		},
+		{
+			name:        "Created",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareTime(repo(a).createdAt, repo(b).createdAt) },
+		},
+		{
+			name:        "Last Updated",
+			defaultDesc: true,
+			compare:     func(a, b list.Item) int { return compareTime(repo(a).pushedAt, repo(b).pushedAt) },
+		},
+	}
+}
+
+func repositoriesTableColumns() []*tableColumn {
+	repo := func(i list.Item) *repositoryItem {
+		return i.(*repositoryItem)
+	}
+	return []*tableColumn{
+		{
+			title:  "Name",
+			flex:   true,
+			sortBy: "Name",
+			value:  func(i list.Item) string { return repo(i).title },
+		},
+		{
+			title: "Lang",
+			width: 12,
+			value: func(i list.Item) string { return repo(i).langName },
+		},
+		{
+			title:  "★",
+			width:  7,
+			align:  lipgloss.Right,
+			sortBy: "Stars",
+			value:  func(i list.Item) string { return fmt.Sprint(repo(i).stars) },
+		},
+		{
+			title:  "Forks",
+			width:  7,
+			align:  lipgloss.Right,
+			sortBy: "Forks",
+			value:  func(i list.Item) string { return fmt.Sprint(repo(i).forks) },
+		},
+		{
+			title: "License",
+			width: 14,
+			value: func(i list.Item) string { return repo(i).license },
+		},
+		{
+			title:  "Updated",
+			width:  12,
+			sortBy: "Last Updated",
+			value:  func(i list.Item) string { return formatShortDate(repo(i).pushedAt) },
+		},
+	}
+}
+
+var repositoriesDefaultSortOrder = sortOrder{
+	primary:     0, // Stars
+	primaryDesc: true,
+	secondary:   noSortField,
 }
 
 type repositoriesModel struct {
@@ -60,128 +131,146 @@ type repositoriesModel struct {
 	originalItems []list.Item
 	spinner       *spinner.Model
 
-	delegateKeys           repositoriesDelegateKeyMap
-	sortDialogDelegateKeys repositoriesSortDialogDelegateKeyMap
-	langDialogDelegateKeys repositoriesLangDialogDelegateKeyMap
+	delegate     repositoryDelegate
+	delegateKeys repositoriesDelegateKeyMap
 
 	errorMsg      *repositoriesErrorMsg
 	loading       bool
+	focus         string
 	selectedUser  string
 	width, height int
 
-	sortType
-	sortDialogOpened bool
+	sorter     *itemSorter
+	sortDialog *sortDialog
+
+	viewsDialog *savedViewsDialog
 
-	langs            []*repositoeisLang
-	langIdx          int
-	langDialogOpened bool
+	query       *queryPrompt
+	queryFilter func(*gh.UserRepository) bool
+
+	langDialog *selectDialog
+
+	preview *previewPane
+	table   *table
 }
 
 type repositoriesDelegateKeyMap struct {
-	sort key.Binding
-	lang key.Binding
-	open key.Binding
-	back key.Binding
-	quit key.Binding
+	sort    key.Binding
+	lang    key.Binding
+	query   key.Binding
+	views   key.Binding
+	open    key.Binding
+	back    key.Binding
+	quit    key.Binding
+	preview previewKeyMap
+	table   tableKeyMap
 }
 
+var repositoriesKeys = registerKeyScope("repositories",
+	newKeyAction("sort", "S", "sort", "S"),
+	newKeyAction("language", "L", "filter by language", "L"),
+	newKeyAction("query", ":", "filter by query", ":"),
+	newKeyAction("views", "V", "saved views", "V"),
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+).with("list", "preview", "table")
+
 func newRepositoriesDelegateKeyMap() repositoriesDelegateKeyMap {
 	return repositoriesDelegateKeyMap{
-		sort: key.NewBinding(
-			key.WithKeys("S"),
-			key.WithHelp("S", "sort"),
-		),
-		lang: key.NewBinding(
-			key.WithKeys("L"),
-			key.WithHelp("L", "filter by language"),
-		),
-		open: key.NewBinding(
-			key.WithKeys("x"),
-			key.WithHelp("x", "open in browser"),
-		),
-		back: key.NewBinding(
-			key.WithKeys("backspace", "ctrl+h"),
-			key.WithHelp("backspace", "back"),
-		),
-		quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
-	}
-}
-
-type repositoriesSortDialogDelegateKeyMap struct {
-	next  key.Binding
-	prev  key.Binding
-	close key.Binding
-}
-
-func newRepositoriesSortDialogDelegateKeyMap() repositoriesSortDialogDelegateKeyMap {
-	return repositoriesSortDialogDelegateKeyMap{
-		next: key.NewBinding(
-			key.WithKeys("j"),
-			key.WithHelp("j", "select next"),
-		),
-		prev: key.NewBinding(
-			key.WithKeys("k"),
-			key.WithHelp("k", "select prev"),
-		),
-		close: key.NewBinding(
-			key.WithKeys("S", "esc", "enter"),
-			key.WithHelp("S", "close dialog"),
-		),
-	}
-}
-
-type repositoriesLangDialogDelegateKeyMap struct {
-	next  key.Binding
-	prev  key.Binding
-	close key.Binding
-}
-
-func newRepositoriesLangDialogDelegateKeyMap() repositoriesLangDialogDelegateKeyMap {
-	return repositoriesLangDialogDelegateKeyMap{
-		next: key.NewBinding(
-			key.WithKeys("j"),
-			key.WithHelp("j", "select next"),
-		),
-		prev: key.NewBinding(
-			key.WithKeys("k"),
-			key.WithHelp("k", "select prev"),
-		),
-		close: key.NewBinding(
-			key.WithKeys("L", "esc", "enter"),
-			key.WithHelp("L", "close dialog"),
-		),
+		sort:    repositoriesKeys.binding("sort"),
+		lang:    repositoriesKeys.binding("language"),
+		query:   repositoriesKeys.binding("query"),
+		views:   repositoriesKeys.binding("views"),
+		open:    repositoriesKeys.binding("open"),
+		back:    repositoriesKeys.binding("back"),
+		quit:    repositoriesKeys.binding("quit"),
+		preview: newPreviewKeyMap(),
+		table:   newTableKeyMap(),
 	}
 }
 
 func newRepositoriesModel(client *gh.GitHubClient, s *spinner.Model) repositoriesModel {
 	delegateKeys := newRepositoriesDelegateKeyMap()
 	delegate := NewRepositoryDelegate(delegateKeys)
-	sortDialogDelegateKeys := newRepositoriesSortDialogDelegateKeyMap()
-	langDialogDelegateKeys := newRepositoriesLangDialogDelegateKeyMap()
 
-	l := list.New(nil, delegate, 0, 0)
+	sorter := newItemSorter(repositoriesPageKey, repositoriesSortFields(), repositoriesDefaultSortOrder)
+	table := newTable(repositoriesPageKey, repositoriesTableColumns(), sorter)
+
+	l := list.New(nil, table.listDelegate(delegate, delegateKeys.table), 0, 0)
 	l.KeyMap.Quit = delegateKeys.quit
 	l.SetShowTitle(false)
-	l.SetFilteringEnabled(false)
+	setupListFiltering(&l)
+	setupListKeys(&l)
 	l.SetShowStatusBar(false)
 
 	return repositoriesModel{
-		client:                 client,
-		list:                   l,
-		spinner:                s,
-		delegateKeys:           delegateKeys,
-		sortDialogDelegateKeys: sortDialogDelegateKeys,
-		langDialogDelegateKeys: langDialogDelegateKeys,
+		client:       client,
+		list:         l,
+		spinner:      s,
+		delegate:     delegate,
+		delegateKeys: delegateKeys,
+		sorter:       sorter,
+		sortDialog:   newSortDialog(sorter, delegateKeys.sort.Keys()),
+		viewsDialog:  newSavedViewsDialog(repositoriesPageKey, delegateKeys.views.Keys()),
+		query:        newQueryPrompt(),
+		langDialog:   newSelectDialog("Language", delegateKeys.lang.Keys(), true),
+		preview:      newPreviewPane(),
+		table:        table,
 	}
 }
 
+func init() {
+	registerPage(func(ctx *pageContext, _ selectRepositoriesPageMsg) page {
+		m := newRepositoriesModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+}
+
 func (m *repositoriesModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
-	m.list.SetSize(width, height-2)
+	m.updateListSize()
+	m.sortDialog.SetSize(width, height)
+	m.langDialog.SetSize(width, height)
+	m.viewsDialog.SetSize(width, height)
+}
+
+func (m *repositoriesModel) updateListSize() {
+	height := m.height - 2 - m.query.height()
+	m.preview.SetSize(m.width, height)
+	if m.table.enabled {
+		// the header of the table
+		height--
+	}
+	m.list.SetSize(m.preview.listWidth(), height)
+	m.table.SetWidth(m.preview.listWidth() - listNormalItemStyle.GetHorizontalFrameSize())
+}
+
+func (m repositoriesModel) itemsTop() int {
+	top := 2 + m.query.height() + listItemsOffset(m.list)
+	if m.table.enabled {
+		// the header of the table
+		top++
+	}
+	return top
+}
+
+func (m repositoriesModel) tableHeaderY() int {
+	// below the title, the query and the filter input
+	return 3 + m.query.height()
+}
+
+func (m *repositoriesModel) updatePreview() tea.Cmd {
+	if !m.preview.visible() || m.loading || m.errorMsg != nil {
+		return nil
+	}
+	item, ok := m.list.SelectedItem().(*repositoryItem)
+	if !ok {
+		return m.preview.show(nil)
+	}
+	return m.preview.show(repositoryPreview(m.client, m.selectedUser, item.repository))
 }
 
 func (m *repositoriesModel) SetUser(id string) {
@@ -190,7 +279,6 @@ func (m *repositoriesModel) SetUser(id string) {
 
 func (m *repositoriesModel) updateItems(repos *gh.UserRepositories) {
 	items := make([]list.Item, len(repos.Repositories))
-	langMap := make(map[string]int)
 	for i, repo := range repos.Repositories {
 		updated := formatDuration(repo.PushedAt)
 		item := &repositoryItem{
@@ -203,103 +291,120 @@ func (m *repositoriesModel) updateItems(repos *gh.UserRepositories) {
 			stars:       repo.Stars,
 			forks:       repo.Forks,
 			watchers:    repo.Watchers,
+			openIssues:  repo.OpenedIssues,
 			url:         repo.Url,
+			createdAt:   repo.CreatedAt,
 			pushedAt:    repo.PushedAt,
+			repository:  repo,
 		}
 		items[i] = item
-		langMap[repo.LangName] += 1
 	}
 
-	m.list.SetItems(items)
 	m.originalItems = items
 
-	m.sortType = sortByStarDesc
+	langs := stats.Languages(repos.Repositories)
+	langItems := make([]selectDialogItem, len(langs))
+	for i, l := range langs {
+		langItems[i] = selectDialogItem{name: l.Name, note: fmt.Sprintf("(%d)", l.Count)}
+	}
+	m.langDialog.setChecked(nil)
+	m.langDialog.setItems(langItems)
 
-	langs := make([]*repositoeisLang, 0, len(langMap)+1)
-	langs = append(langs, &repositoeisLang{name: "All", count: len(items)})
-	for k, v := range langMap {
-		langs = append(langs, &repositoeisLang{name: k, count: v})
+	if v := defaultSavedView(repositoriesPageKey); v != nil {
+		m.applyView(v)
+		return
 	}
-	sort.Slice(langs, func(i, j int) bool {
-		if langs[i].count == langs[j].count {
-			return langs[i].name < langs[j].name
-		}
-		return langs[i].count > langs[j].count
-	})
-	m.langs = langs
-	m.langIdx = 0
-}
-
-func (m *repositoriesModel) updateSortType(reverse bool) {
-	if reverse {
-		switch m.sortType {
-		case sortByStarDesc:
-			m.sortType = sortByUpdatedAsc
-		case sortByStarAsc:
-			m.sortType = sortByStarDesc
-		case sortByUpdatedDesc:
-			m.sortType = sortByStarAsc
-		case sortByUpdatedAsc:
-			m.sortType = sortByUpdatedDesc
+	m.updateListItems()
+}
+
+func (m *repositoriesModel) updateListItems() tea.Cmd {
+	langs := m.langDialog.checkedNames()
+	items := make([]list.Item, 0)
+	for _, i := range m.originalItems {
+		item := i.(*repositoryItem)
+		if len(langs) > 0 && !m.langDialog.isChecked(stats.LanguageName(item.langName)) {
+			continue
 		}
-	} else {
-		switch m.sortType {
-		case sortByStarDesc:
-			m.sortType = sortByStarAsc
-		case sortByStarAsc:
-			m.sortType = sortByUpdatedDesc
-		case sortByUpdatedDesc:
-			m.sortType = sortByUpdatedAsc
-		case sortByUpdatedAsc:
-			m.sortType = sortByStarDesc
+		if m.queryFilter != nil && !m.queryFilter(item.repository) {
+			continue
 		}
+		items = append(items, i)
 	}
+	return m.list.SetItems(m.sorter.sort(items))
 }
 
-func (m *repositoriesModel) sortItems() {
-	items := m.list.Items()
-	switch m.sortType {
-	case sortByStarDesc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].(*repositoryItem).stars > items[j].(*repositoryItem).stars
-		})
-	case sortByStarAsc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].(*repositoryItem).stars < items[j].(*repositoryItem).stars
-		})
-	case sortByUpdatedDesc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].(*repositoryItem).pushedAt.After(items[j].(*repositoryItem).pushedAt)
-		})
-	case sortByUpdatedAsc:
-		sort.Slice(items, func(i, j int) bool {
-			return items[i].(*repositoryItem).pushedAt.Before(items[j].(*repositoryItem).pushedAt)
-		})
+func (m *repositoriesModel) setQuery(s string) error {
+	q, err := query.Parse(s)
+	if err != nil {
+		return err
 	}
-	m.list.SetItems(items)
+	filter, err := query.Compile(q, query.RepositorySchema)
+	if err != nil {
+		return err
+	}
+	if q.Empty() {
+		filter = nil
+	}
+	m.queryFilter = filter
+	m.query.apply(strings.TrimSpace(s))
+	m.updateListSize()
+	return nil
 }
 
-func (m *repositoriesModel) updateLangIdx(reverse bool) {
-	n := len(m.langs)
-	if reverse {
-		m.langIdx = ((m.langIdx-1)%n + n) % n
-	} else {
-		m.langIdx = (m.langIdx + 1) % n
+func (m *repositoriesModel) applyQuery(s string) tea.Cmd {
+	if err := m.setQuery(s); err != nil {
+		m.query.fail(err)
+		return nil
 	}
+	m.list.ResetSelected()
+	return m.updateListItems()
 }
 
-func (m *repositoriesModel) filterItems() {
-	if m.langs[m.langIdx].name == "All" {
-		m.list.SetItems(m.originalItems)
-		return
+func (m *repositoriesModel) currentView(name string) *savedView {
+	v := &savedView{
+		Name:  name,
+		Page:  repositoriesPageKey,
+		Query: m.query.applied,
+		Sort:  m.sorter.export(),
 	}
-	items := make([]list.Item, 0)
-	for _, i := range m.originalItems {
-		if i.(*repositoryItem).langName == m.langs[m.langIdx].name {
-			items = append(items, i)
+	v.Filters = m.langDialog.checkedNames()
+	return v
+}
+
+func (m *repositoriesModel) applyView(v *savedView) tea.Cmd {
+	m.langDialog.setChecked(v.Filters)
+	m.sorter.restore(v.Sort)
+	var cmd tea.Cmd
+	if err := m.setQuery(v.Query); err != nil {
+		cmd = m.query.edit(v.Query)
+		m.query.fail(err)
+		m.updateListSize()
+	}
+	m.list.ResetSelected()
+	return tea.Batch(cmd, m.updateListItems())
+}
+
+// focusItem selects the repository with the name,
+// clearing the filters if the repository is hidden by them.
+func (m *repositoriesModel) focusItem(name string) {
+	find := func() bool {
+		for i, item := range m.list.Items() {
+			if item.(*repositoryItem).title == name {
+				m.list.Select(i)
+				return true
+			}
 		}
+		return false
+	}
+	if find() {
+		return
 	}
-	m.list.SetItems(items)
+	m.langDialog.setChecked(nil)
+	m.queryFilter = nil
+	m.query.apply("")
+	m.updateListSize()
+	m.updateListItems()
+	find()
 }
 
 func (m repositoriesModel) Init() tea.Cmd {
@@ -342,71 +447,137 @@ func (m repositoriesModel) openRepositoryPageInBrowser(item *repositoryItem) tea
 	}
 }
 
+func (m repositoriesModel) capturingInput() bool {
+	return m.query.editing || m.list.FilterState() == list.Filtering ||
+		m.viewsDialog.opened || m.sortDialog.opened || m.langDialog.opened
+}
+
 func (m repositoriesModel) Update(msg tea.Msg) (repositoriesModel, tea.Cmd) {
+	m, cmd := m.update(msg)
+	return m, tea.Batch(cmd, m.updatePreview())
+}
+
+func (m repositoriesModel) update(msg tea.Msg) (repositoriesModel, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0)
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		if m.loading {
 			return m, nil
 		}
-		if m.sortDialogOpened {
-			switch {
-			case key.Matches(msg, m.sortDialogDelegateKeys.close):
-				m.sortDialogOpened = false
-			case key.Matches(msg, m.sortDialogDelegateKeys.next):
-				m.list.ResetSelected()
-				m.updateSortType(false)
-				m.sortItems()
-			case key.Matches(msg, m.sortDialogDelegateKeys.prev):
-				m.list.ResetSelected()
-				m.updateSortType(true)
-				m.sortItems()
+		if m.query.editing {
+			submitted, cmd := m.query.update(msg)
+			if submitted {
+				return m, m.applyQuery(m.query.value())
 			}
+			m.updateListSize()
+			return m, cmd
+		}
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+		if m.viewsDialog.opened {
+			switch action, cmd := m.viewsDialog.update(msg); action {
+			case savedViewsApplyAction:
+				return m, m.applyView(m.viewsDialog.selected())
+			case savedViewsSaveAction:
+				m.viewsDialog.add(m.currentView(m.viewsDialog.name()))
+				return m, nil
+			default:
+				return m, cmd
+			}
+		}
+		if m.sortDialog.opened || m.langDialog.opened {
+			return m, m.updateDialogs(msg)
+		}
+		if m.preview.updateKey(msg, m.delegateKeys.preview) {
+			m.updateListSize()
 			return m, nil
 		}
-		if m.langDialogOpened {
-			switch {
-			case key.Matches(msg, m.langDialogDelegateKeys.close):
-				m.langDialogOpened = false
-			case key.Matches(msg, m.langDialogDelegateKeys.next):
+		if handled, sorted := m.table.updateKey(msg, m.delegateKeys.table); handled {
+			m.list.SetDelegate(m.table.listDelegate(m.delegate, m.delegateKeys.table))
+			m.updateListSize()
+			if sorted {
 				m.list.ResetSelected()
-				m.updateLangIdx(false)
-				m.filterItems()
-			case key.Matches(msg, m.langDialogDelegateKeys.prev):
-				m.list.ResetSelected()
-				m.updateLangIdx(true)
-				m.filterItems()
+				return m, m.updateListItems()
 			}
 			return m, nil
 		}
 		switch {
 		case key.Matches(msg, m.delegateKeys.sort):
-			m.sortDialogOpened = true
+			m.sortDialog.open()
 			return m, nil
 		case key.Matches(msg, m.delegateKeys.lang):
-			m.langDialogOpened = true
+			m.langDialog.open(0)
+			return m, nil
+		case key.Matches(msg, m.delegateKeys.query):
+			cmd := m.query.open()
+			m.updateListSize()
+			return m, cmd
+		case key.Matches(msg, m.delegateKeys.views):
+			m.viewsDialog.open()
 			return m, nil
 		case key.Matches(msg, m.delegateKeys.open):
-			item := m.list.SelectedItem().(*repositoryItem)
+			item, ok := m.list.SelectedItem().(*repositoryItem)
+			if !ok {
+				return m, nil
+			}
 			return m, m.openRepositoryPageInBrowser(item)
 		case key.Matches(msg, m.delegateKeys.back):
 			if m.list.FilterState() != list.Filtering {
-				return m, goBackMenuPage
+				return m, goBack
 			}
 		}
+	case tea.MouseMsg:
+		if m.viewsDialog.opened {
+			if action, _ := m.viewsDialog.update(msg); action == savedViewsApplyAction {
+				return m, m.applyView(m.viewsDialog.selected())
+			}
+			return m, nil
+		}
+		if m.sortDialog.opened || m.langDialog.opened {
+			return m, m.updateDialogs(msg)
+		}
+		if m.table.clickHeader(msg, m.tableHeaderY()) {
+			m.list.ResetSelected()
+			return m, m.updateListItems()
+		}
+		if m.loading || m.errorMsg != nil || m.preview.updateMouse(msg) {
+			return m, nil
+		}
+		if updateListMouse(&m.list, m.table.listDelegate(m.delegate, m.delegateKeys.table), msg, m.itemsTop()) {
+			return m.update(keyPress(m.delegateKeys.open))
+		}
+		return m, nil
 	case selectRepositoriesPageMsg:
 		m.loading = true
+		m.focus = msg.focus
 		return m, m.loadRepositores(msg.id)
 	case repositoriesSuccessMsg:
 		m.errorMsg = nil
 		m.loading = false
+		m.preview.show(nil)
 		m.list.ResetSelected()
 		m.updateItems(msg.repos)
+		if m.focus != "" {
+			m.focusItem(m.focus)
+			m.focus = ""
+		}
 		return m, nil
 	case repositoriesErrorMsg:
 		m.errorMsg = &msg
 		m.loading = false
 		return m, nil
+	case previewLoadMsg, previewBodyMsg:
+		return m, m.preview.update(msg)
+	}
+
+	if m.query.editing {
+		_, qCmd := m.query.update(msg)
+		cmds = append(cmds, qCmd)
+	}
+	if m.viewsDialog.naming {
+		_, vCmd := m.viewsDialog.update(msg)
+		cmds = append(cmds, vCmd)
 	}
 
 	list, lCmd := m.list.Update(msg)
@@ -416,74 +587,59 @@ func (m repositoriesModel) Update(msg tea.Msg) (repositoriesModel, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-func (m repositoriesModel) View() string {
-	if m.loading {
-		return loadingView(m.spinner, m.breadcrumb())
-	}
-	if m.errorMsg != nil {
-		return m.errorView()
-	}
-	ret := titleView(m.breadcrumb()) + listView(m.list)
-	if m.sortDialogOpened {
-		return m.withSortDialogView(ret)
+func (m *repositoriesModel) updateDialogs(msg tea.Msg) tea.Cmd {
+	if m.sortDialog.opened {
+		if m.sortDialog.update(msg) {
+			m.list.ResetSelected()
+			return m.updateListItems()
+		}
+		return nil
 	}
-	if m.langDialogOpened {
-		return m.withLangDialogView(ret)
+	if m.langDialog.update(msg) == selectDialogChanged {
+		m.list.ResetSelected()
+		return m.updateListItems()
 	}
-	return ret
+	return nil
 }
 
-func (m repositoriesModel) withSortDialogView(base string) string {
-	title := repositoriesDialogTitleStyle.Render("Sort")
-
-	body := strings.Join([]string{
-		m.sortKeySelectItemView("Stars (Desc)", sortByStarDesc),
-		m.sortKeySelectItemView("Stars (Asc)", sortByStarAsc),
-		m.sortKeySelectItemView("Last Updated (Desc)", sortByUpdatedDesc),
-		m.sortKeySelectItemView("Last Updated (Asc)", sortByUpdatedAsc),
-	}, "\n")
-	body = repositoriesDialogBodyStyle.Render(body)
-
-	dialog := reposirotiesDialogStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, body))
-
-	dw, dh := lipgloss.Size(dialog)
-	top := (m.height / 2) - (dh / 2)
-	left := (m.width / 2) - (dw / 2)
-	return kasane.OverlayString(base, dialog, top, left, kasane.WithPadding(m.width))
+func (m repositoriesModel) keyBindings() [][]key.Binding {
+	return listKeyBindings(m.list)
 }
 
-func (m repositoriesModel) sortKeySelectItemView(s string, st sortType) string {
-	if m.sortType == st {
-		return repositoriesDialogSelectedStyle.Render("> " + s)
-	} else {
-		return repositoriesDialogNotSelectedStyle.Render("  " + s)
+func (m repositoriesModel) yankTargets() (*yankTarget, []*yankTarget) {
+	if m.loading || m.errorMsg != nil {
+		return nil, nil
 	}
+	return listYankTargets(m.list, func(item *repositoryItem) *yankTarget {
+		return repositoryYankTarget(m.selectedUser+"/"+item.title, item.url)
+	})
 }
 
-func (m repositoriesModel) withLangDialogView(base string) string {
-	title := repositoriesDialogTitleStyle.Render("Language")
-
-	ivs := make([]string, len(m.langs))
-	for i, l := range m.langs {
-		ivs[i] = m.langKeySelectItemView(l)
+func (m repositoriesModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
 	}
-	body := strings.Join(ivs, "\n")
-	body = repositoriesDialogBodyStyle.Render(body)
-
-	dialog := reposirotiesDialogStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, body))
-
-	dw, dh := lipgloss.Size(dialog)
-	top := (m.height / 2) - (dh / 2)
-	left := (m.width / 2) - (dw / 2)
-	return kasane.OverlayString(base, dialog, top, left, kasane.WithPadding(m.width))
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	ret := titleView(m.breadcrumb()) + m.query.view() + m.preview.splitView(m.listView())
+	if m.viewsDialog.opened {
+		return m.viewsDialog.view(ret)
+	}
+	if m.sortDialog.opened {
+		return m.sortDialog.view(ret)
+	}
+	if m.langDialog.opened {
+		return m.langDialog.view(ret)
+	}
+	return ret
 }
 
-func (m repositoriesModel) langKeySelectItemView(lang *repositoeisLang) string {
-	if m.langs[m.langIdx].name == lang.name {
-		return repositoriesDialogSelectedStyle.Render(fmt.Sprintf("> %s (%d)", lang.name, lang.count))
-	} else {
-		return repositoriesDialogNotSelectedStyle.Render(fmt.Sprintf("  %s (%d)", lang.name, lang.count))
+func (m repositoriesModel) listView() string {
+	if m.table.enabled {
+		return tableListView(m.list, m.table)
 	}
+	return listView(m.list)
 }
 
 func (m repositoriesModel) errorView() string {
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+func equal(x, y interface{}) bool {
+	return reflect.DeepEqual(x, y)
+}
+
+func notEqual(x, y interface{}) bool {
+	return !equal(x, y)
+}
+
+func TestRepositoriesOpenFiltered(t *testing.T) {
+	// the saved views and the page settings are read from the config dir
+	t.Setenv("HOME", t.TempDir())
+
+	repos := &gh.UserRepositories{
+		TotalCount: 1,
+		Repositories: []*gh.UserRepository{
+			{Name: "foo", Stars: 10, Url: "https://github.com/bar/foo"},
+		},
+	}
+	tests := []struct {
+		query    string
+		items    int
+		openable bool
+	}{
+		{
+			query:    "",
+			items:    1,
+			openable: true,
+		},
+		{
+			query:    "stars:>5",
+			items:    1,
+			openable: true,
+		},
+		{
+			query:    "stars:>100",
+			items:    0,
+			openable: false,
+		},
+	}
+	for _, test := range tests {
+		s := spinner.New()
+		m := newRepositoriesModel(nil, &s)
+		m.SetSize(80, 24)
+		m, _ = m.Update(repositoriesSuccessMsg{repos})
+		m.applyQuery(test.query)
+
+		if got := len(m.list.VisibleItems()); notEqual(got, test.items) {
+			t.Errorf("query: %s, items got: %d, want: %d", test.query, got, test.items)
+		}
+		// pressing the open key must not fail when no item is left
+		_, cmd := m.Update(keyPress(m.delegateKeys.open))
+		if got := cmd != nil; notEqual(got, test.openable) {
+			t.Errorf("query: %s, openable got: %v, want: %v", test.query, got, test.openable)
+		}
+	}
+}
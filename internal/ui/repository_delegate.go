@@ -9,6 +9,8 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 	"github.com/muesli/reflow/truncate"
 )
 
@@ -26,8 +28,11 @@ type repositoryItem struct {
 	stars       int
 	forks       int
 	watchers    int
+	openIssues  int
 	url         string
+	createdAt   time.Time
 	pushedAt    time.Time
+	repository  *gh.UserRepository
 }
 
 func (i repositoryItem) titleStr() string {
@@ -46,7 +51,7 @@ func (i repositoryItem) styledLangColor() string {
 	// U+26AB will be displayed as emoji
 	// U+2B24 is too large
 	s := "◍ "
-	style := lipgloss.NewStyle().Foreground(lipgloss.Color(i.langColor))
+	style := lipgloss.NewStyle().Foreground(theme.Language(i.langColor))
 	return style.Render(s)
 }
 
@@ -55,7 +60,7 @@ func (i repositoryItem) detailsStr() string {
 	if license == "" {
 		license = "-"
 	}
-	return fmt.Sprintf("%s   ⚖ %s   Updated %s", i.langName, license, i.updated)
+	return fmt.Sprintf("   ⚖ %s   Updated %s", license, i.updated)
 }
 
 func (i repositoryItem) countsStr() string {
@@ -64,8 +69,12 @@ func (i repositoryItem) countsStr() string {
 
 var _ list.Item = (*repositoryItem)(nil)
 
+func (i repositoryItem) filterValue() filterValue {
+	return filterValue{i.title, i.description, i.langName}
+}
+
 func (i repositoryItem) FilterValue() string {
-	return i.title
+	return i.filterValue().String()
 }
 
 type repositoryDelegate struct {
@@ -81,7 +90,7 @@ func NewRepositoryDelegate(delegateKeys repositoriesDelegateKeyMap) repositoryDe
 		return []key.Binding{delegateKeys.open, delegateKeys.back}
 	}
 	fullHelpFunc := func() [][]key.Binding {
-		return [][]key.Binding{{delegateKeys.sort, delegateKeys.lang, delegateKeys.open, delegateKeys.back}}
+		return [][]key.Binding{{delegateKeys.sort, delegateKeys.lang, delegateKeys.query, delegateKeys.views, delegateKeys.open, delegateKeys.table.toggle, delegateKeys.preview.toggle, delegateKeys.preview.down, delegateKeys.back}}
 	}
 
 	return repositoryDelegate{
@@ -104,10 +113,20 @@ func (d repositoryDelegate) Update(msg tea.Msg, m *list.Model) tea.Cmd {
 
 func (d repositoryDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
 	i := item.(*repositoryItem)
-	title := i.titleStr()
-	desc := i.descStr()
+	fv := i.filterValue()
+	matches := listItemMatches(m, index)
+	selected := index == m.Index()
+
+	titleColorStyle, descColorStyle := listNormalTitleColorStyle, listNormalDescColorStyle
+	if selected {
+		titleColorStyle, descColorStyle = listSelectedTitleColorStyle, listSelectedDescColorStyle
+	}
+
+	title := highlightMatches(i.titleStr(), fv.matchesOf(0, matches), titleColorStyle)
+	desc := highlightMatches(i.descStr(), fv.matchesOf(1, matches), descColorStyle)
+	lang := highlightMatches(i.langName, fv.matchesOf(2, matches), descColorStyle)
 	detailsLangColor := i.styledLangColor()
-	details := i.detailsStr()
+	details := lang + descColorStyle.Render(i.detailsStr())
 	counts := i.countsStr()
 
 	if m.Width() > 0 {
@@ -117,17 +136,15 @@ func (d repositoryDelegate) Render(w io.Writer, m list.Model, index int, item li
 		// todo: considering max width
 	}
 
-	if index == m.Index() {
-		title = listSelectedTitleStyle.Render(title)
-		desc = listSelectedDescStyle.Render(desc)
+	if selected {
+		title = listSelectedItemStyle.Render(title)
+		desc = listSelectedItemStyle.Render(desc)
 		counts = listSelectedDescStyle.Render(counts)
-		details = listSelectedDescColorStyle.Render(details)
 		details = listSelectedItemStyle.Render(detailsLangColor + details)
 	} else {
-		title = listNormalTitleStyle.Render(title)
-		desc = listNormalDescStyle.Render(desc)
+		title = listNormalItemStyle.Render(title)
+		desc = listNormalItemStyle.Render(desc)
 		counts = listNormalDescStyle.Render(counts)
-		details = listNormalDescColorStyle.Render(details)
 		details = listNormalItemStyle.Render(detailsLangColor + details)
 	}
 
@@ -0,0 +1,285 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/stats"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+var (
+	repositoryStatsErrorStyle = lipgloss.NewStyle().
+					Padding(2, 0, 0, 2).
+					Foreground(theme.Error.Color())
+
+	repositoryStatsViewportStyle = lipgloss.NewStyle().
+					Padding(1, 0, 0, 2)
+
+	statsGridEmptyStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color())
+)
+
+type repositoryStatsModel struct {
+	client *gh.GitHubClient
+
+	keys     statsKeyMap
+	viewport viewport.Model
+	help     help.Model
+	stats    *stats.Repositories
+	spinner  *spinner.Model
+
+	errorMsg      *repositoryStatsErrorMsg
+	loading       bool
+	selectedUser  string
+	width, height int
+}
+
+var repositoryStatsKeys = registerKeyScope("repo-stats",
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+func newRepositoryStatsModel(client *gh.GitHubClient, s *spinner.Model) repositoryStatsModel {
+	keys := statsKeyMap{
+		Back: repositoryStatsKeys.binding("back"),
+		Quit: repositoryStatsKeys.binding("quit"),
+	}
+	return repositoryStatsModel{
+		client:   client,
+		keys:     keys,
+		viewport: viewport.New(0, 0),
+		help:     help.New(),
+		spinner:  s,
+	}
+}
+
+func init() {
+	registerPage(func(ctx *pageContext, _ selectRepositoryStatsPageMsg) page {
+		m := newRepositoryStatsModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+}
+
+func (m *repositoryStatsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	t, r, b, l := repositoryStatsViewportStyle.GetPadding()
+	m.viewport.Width = width - r - l
+	m.viewport.Height = height - 4 - t - b
+	m.updateContent()
+}
+
+func (m *repositoryStatsModel) SetUser(id string) {
+	m.selectedUser = id
+}
+
+func (m *repositoryStatsModel) updateContent() {
+	if m.stats == nil {
+		return
+	}
+	m.viewport.SetContent(m.statsContentsView())
+}
+
+func (m repositoryStatsModel) Init() tea.Cmd {
+	return nil
+}
+
+type repositoryStatsSuccessMsg struct {
+	stats *stats.Repositories
+}
+
+var _ tea.Msg = (*repositoryStatsSuccessMsg)(nil)
+
+type repositoryStatsErrorMsg struct {
+	e       error
+	summary string
+}
+
+var _ tea.Msg = (*repositoryStatsErrorMsg)(nil)
+
+func (m repositoryStatsModel) loadStats(id string) tea.Cmd {
+	return func() tea.Msg {
+		repos, err := m.client.QueryUserRepositories(id)
+		if err != nil {
+			return repositoryStatsErrorMsg{err, "failed to fetch repositories"}
+		}
+		return repositoryStatsSuccessMsg{stats.RepositoryStats(repos, time.Now())}
+	}
+}
+
+func (m repositoryStatsModel) Update(msg tea.Msg) (repositoryStatsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			return m, goBack
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case selectRepositoryStatsPageMsg:
+		m.loading = true
+		return m, m.loadStats(msg.id)
+	case repositoryStatsSuccessMsg:
+		m.errorMsg = nil
+		m.loading = false
+		m.stats = msg.stats
+		m.updateContent()
+		m.viewport.GotoTop()
+		return m, nil
+	case repositoryStatsErrorMsg:
+		m.errorMsg = &msg
+		m.loading = false
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+func (m repositoryStatsModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m repositoryStatsModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
+	}
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	return m.statsView()
+}
+
+func (m repositoryStatsModel) statsView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	vp := repositoryStatsViewportStyle.Render(m.viewport.View())
+	ret += vp
+	height -= cn(vp)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m repositoryStatsModel) statsContentsView() string {
+	s := m.stats
+	if s.Total == 0 {
+		return "No repositories"
+	}
+	chartWidth := min(m.viewport.Width-2, 60)
+
+	summary := []string{
+		statsItemView("Repositories", fmt.Sprint(s.Total)),
+		statsItemView("Stars", fmt.Sprint(s.Stars)),
+		statsItemView("Forks", fmt.Sprint(s.Forks)),
+		statsItemView("Watchers", fmt.Sprint(s.Watchers)),
+	}
+
+	ret := ""
+	ret += statsSectionView("Summary", strings.Join(summary, "\n"))
+	ret += statsSectionView("Languages (by repositories)", barChartView(statsTop(s.LanguagesByCount), chartWidth))
+	ret += statsSectionView("Languages (by stars)", barChartView(statsTop(s.LanguagesByStars), chartWidth))
+	ret += statsSectionView("Licenses", barChartView(s.Licenses, chartWidth))
+	ret += statsSectionView("Created (rows) vs last pushed (columns)", statsAgeGridView(s.AgeVsPush))
+	ret += statsSectionView("Not pushed for over a year", statsStaleView(s.Stale))
+	return ret
+}
+
+func statsAgeGridView(grid [][]int) string {
+	labelWidth, cellWidth := 0, 0
+	for _, p := range stats.AgePeriods {
+		labelWidth = max(labelWidth, lipgloss.Width(p.Name))
+		cellWidth = max(cellWidth, lipgloss.Width(p.Name)+2)
+	}
+	cell := func(s string) string {
+		return fmt.Sprintf("%*s", cellWidth, s)
+	}
+
+	header := strings.Repeat(" ", labelWidth)
+	for _, p := range stats.AgePeriods {
+		header += chartLabelStyle.Render(cell(p.Name))
+	}
+	lines := []string{header}
+	for i, p := range stats.AgePeriods {
+		line := chartLabelStyle.Render(fmt.Sprintf("%-*s", labelWidth, p.Name))
+		for _, n := range grid[i] {
+			if n == 0 {
+				line += statsGridEmptyStyle.Render(cell("·"))
+			} else {
+				line += chartBarStyle.Render(cell(fmt.Sprint(n)))
+			}
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func statsStaleView(repos []*gh.UserRepository) string {
+	nameWidth := 0
+	for _, r := range repos {
+		nameWidth = max(nameWidth, lipgloss.Width(r.Name))
+	}
+	lines := make([]string, len(repos))
+	for i, r := range repos {
+		lines[i] = fmt.Sprintf("%-*s  %s", nameWidth, r.Name, chartLabelStyle.Render("Updated "+formatDuration(r.PushedAt)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m repositoryStatsModel) errorView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	errorText := repositoryStatsErrorStyle.Render("ERROR: " + m.errorMsg.summary)
+	ret += errorText
+	height -= cn(errorText)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m repositoryStatsModel) breadcrumb() []string {
+	return []string{m.selectedUser, "Repo Stats"}
+}
@@ -0,0 +1,316 @@
+package ui
+
+import (
+	"reflect"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+const (
+	// older jumps are forgotten
+	routerHistoryMaxLength = 100
+)
+
+// page is a screen of the application managed by the router.
+type page interface {
+	Update(msg tea.Msg) tea.Cmd
+	View() string
+	SetSize(width, height int)
+}
+
+// pageModel is implemented by the page models, whose Update returns the updated model.
+type pageModel[T any] interface {
+	Update(msg tea.Msg) (T, tea.Cmd)
+	View() string
+}
+
+// routedPage adapts a page model to page.
+type routedPage[T pageModel[T], PT interface {
+	*T
+	SetSize(width, height int)
+}] struct {
+	model T
+}
+
+func newRoutedPage[T pageModel[T], PT interface {
+	*T
+	SetSize(width, height int)
+}](m T) *routedPage[T, PT] {
+	return &routedPage[T, PT]{model: m}
+}
+
+func (p *routedPage[T, PT]) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	p.model, cmd = p.model.Update(msg)
+	return addressTo(p, cmd)
+}
+
+// pageMsg is a message resulting from a command of the page, which is returned to the page even if it is not shown,
+// such as the result of loading a section which is switched away from while loading.
+type pageMsg struct {
+	to  page
+	msg tea.Msg
+}
+
+var _ tea.Msg = (*pageMsg)(nil)
+
+func addressTo(p page, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case nil:
+			return nil
+		case tea.BatchMsg:
+			cmds := make(tea.BatchMsg, len(msg))
+			for i, c := range msg {
+				cmds[i] = addressTo(p, c)
+			}
+			return cmds
+		default:
+			// the messages to the program itself, such as tea.QuitMsg, are not addressed
+			if reflect.TypeOf(msg).PkgPath() == bubbleteaPkgPath {
+				return msg
+			}
+			return pageMsg{p, msg}
+		}
+	}
+}
+
+func (p *routedPage[T, PT]) View() string {
+	return p.model.View()
+}
+
+func (p *routedPage[T, PT]) SetSize(width, height int) {
+	PT(&p.model).SetSize(width, height)
+}
+
+func (p *routedPage[T, PT]) capturingInput() bool {
+	c, ok := any(p.model).(inputCapturer)
+	return ok && c.capturingInput()
+}
+
+// inputCapturer is implemented by the pages that take text input,
+// so that the keys typed in are not handled by the application.
+type inputCapturer interface {
+	capturingInput() bool
+}
+
+func (p *routedPage[T, PT]) keyBindings() [][]key.Binding {
+	k, ok := any(p.model).(keyBinder)
+	if !ok {
+		return nil
+	}
+	return k.keyBindings()
+}
+
+// keyBinder is implemented by the pages to list their keys in the command palette, as the full help does.
+type keyBinder interface {
+	keyBindings() [][]key.Binding
+}
+
+func (p *routedPage[T, PT]) breadcrumb() []string {
+	b, ok := any(p.model).(breadcrumber)
+	if !ok {
+		return nil
+	}
+	return b.breadcrumb()
+}
+
+// breadcrumber is implemented by the pages which show where they are in the title.
+type breadcrumber interface {
+	breadcrumb() []string
+}
+
+func (p *routedPage[T, PT]) yankTargets() (*yankTarget, []*yankTarget) {
+	y, ok := any(p.model).(yanker)
+	if !ok {
+		return nil, nil
+	}
+	return y.yankTargets()
+}
+
+// pageContext is passed to the constructors of the pages.
+type pageContext struct {
+	client  *gh.GitHubClient
+	spinner *spinner.Model
+	// the selected user when the page is opened
+	user string
+	// the users selected before, the oldest first
+	previousUsers []string
+}
+
+var bubbleteaPkgPath = reflect.TypeOf(tea.QuitMsg{}).PkgPath()
+
+var pageRoutes = make(map[reflect.Type]func(*pageContext, tea.Msg) page)
+
+// registerPage registers the page opened by the message of type M.
+// When the message is sent, the router pushes the page returned by newPage, and then passes the message to it.
+func registerPage[M tea.Msg](newPage func(ctx *pageContext, msg M) page) {
+	var msg M
+	pageRoutes[reflect.TypeOf(msg)] = func(ctx *pageContext, msg tea.Msg) page {
+		return newPage(ctx, msg.(M))
+	}
+}
+
+type goBackMsg struct{}
+
+var _ tea.Msg = (*goBackMsg)(nil)
+
+// goBack returns to the previous page.
+func goBack() tea.Msg {
+	return goBackMsg{}
+}
+
+type route struct {
+	page    page
+	user    string       // the selected user of the page
+	section *userSection // the section of the user shown by the page, if not nil
+}
+
+// router manages the pages as a stack, the last is the current page.
+// The previous stacks are kept in history, so that the user can jump back and forth as in a browser.
+type router struct {
+	stack   []*route
+	history [][]*route
+	current int // index of history
+
+	width, height int
+}
+
+func (r *router) top() *route {
+	return r.stack[len(r.stack)-1]
+}
+
+func (r *router) isRoot() bool {
+	return len(r.stack) == 1
+}
+
+func (r *router) users() []string {
+	users := make([]string, 0)
+	for _, rt := range r.stack {
+		if rt.user != "" && (len(users) == 0 || users[len(users)-1] != rt.user) {
+			users = append(users, rt.user)
+		}
+	}
+	return users
+}
+
+// section returns the index of the page of a section of the current user nearest to the top, or -1.
+func (r *router) section() int {
+	user := r.top().user
+	for i := len(r.stack) - 1; i >= 0 && r.stack[i].user == user; i-- {
+		if r.stack[i].section != nil {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *router) SetSize(width, height int) {
+	r.width = width
+	r.height = height
+	// pages only in the history may be shown again
+	seen := make(map[page]bool)
+	for _, stack := range append(r.history, r.stack) {
+		for _, rt := range stack {
+			if !seen[rt.page] {
+				seen[rt.page] = true
+				r.setPageSize(rt)
+			}
+		}
+	}
+}
+
+func (r *router) setPageSize(rt *route) {
+	if hasTabBar(rt) {
+		rt.page.SetSize(r.width, r.height-tabBarHeight)
+	} else {
+		rt.page.SetSize(r.width, r.height)
+	}
+}
+
+// hasTabBar reports whether the tab bar is shown with the page, which is the case of the pages of a user.
+func hasTabBar(rt *route) bool {
+	return rt.user != ""
+}
+
+func (r *router) record() {
+	r.history = append(r.history[:min(r.current+1, len(r.history))], cloneRoutes(r.stack))
+	if len(r.history) > routerHistoryMaxLength {
+		r.history = r.history[len(r.history)-routerHistoryMaxLength:]
+	}
+	r.current = len(r.history) - 1
+}
+
+func (r *router) push(rt *route) {
+	r.setPageSize(rt)
+	r.stack = append(cloneRoutes(r.stack), rt)
+	r.record()
+}
+
+// replace removes the pages from the index to the top and pushes the page.
+func (r *router) replace(i int, rt *route) {
+	r.setPageSize(rt)
+	r.stack = append(cloneRoutes(r.stack[:i]), rt)
+	r.record()
+}
+
+func (r *router) pop() bool {
+	if r.isRoot() {
+		return false
+	}
+	r.stack = cloneRoutes(r.stack[:len(r.stack)-1])
+	r.record()
+	return true
+}
+
+// popToBreadcrumb returns to the nearest page whose breadcrumb ends with the name, and reports whether it is found.
+func (r *router) popToBreadcrumb(name string) bool {
+	for i := len(r.stack) - 2; i > 0; i-- {
+		b, ok := r.stack[i].page.(breadcrumber)
+		if !ok {
+			continue
+		}
+		if bcs := b.breadcrumb(); len(bcs) > 0 && bcs[len(bcs)-1] == name {
+			r.stack = cloneRoutes(r.stack[:i+1])
+			r.record()
+			return true
+		}
+	}
+	return false
+}
+
+func (r *router) popToRoot() {
+	if r.isRoot() {
+		return
+	}
+	r.stack = cloneRoutes(r.stack[:1])
+	r.record()
+}
+
+func (r *router) jumpBack() bool {
+	if r.current == 0 {
+		return false
+	}
+	r.current--
+	r.stack = cloneRoutes(r.history[r.current])
+	return true
+}
+
+func (r *router) jumpForward() bool {
+	if r.current >= len(r.history)-1 {
+		return false
+	}
+	r.current++
+	r.stack = cloneRoutes(r.history[r.current])
+	return true
+}
+
+func cloneRoutes(routes []*route) []*route {
+	return append([]*route{}, routes...)
+}
@@ -0,0 +1,265 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type testPageModel struct {
+	name     string
+	bcs      []string // the breadcrumb, which is the name if nil
+	received []tea.Msg
+}
+
+type testLoadedMsg struct{}
+
+func (m testPageModel) Update(msg tea.Msg) (testPageModel, tea.Cmd) {
+	m.received = append(m.received, msg)
+	return m, func() tea.Msg { return testLoadedMsg{} }
+}
+
+func (m testPageModel) View() string {
+	return m.name
+}
+
+func (m *testPageModel) SetSize(width, height int) {}
+
+func (m testPageModel) breadcrumb() []string {
+	if m.bcs != nil {
+		return m.bcs
+	}
+	return []string{m.name}
+}
+
+func newTestPage(name string) *routedPage[testPageModel, *testPageModel] {
+	return newRoutedPage(testPageModel{name: name})
+}
+
+func newTestRouter(names ...string) *router {
+	r := &router{}
+	for _, name := range names {
+		r.push(&route{page: newTestPage(name)})
+	}
+	return r
+}
+
+func stackNames(r *router) []string {
+	names := make([]string, len(r.stack))
+	for i, rt := range r.stack {
+		names[i] = rt.page.View()
+	}
+	return names
+}
+
+func TestRouterHistory(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(r *router)
+		want []string
+		back bool // whether jumpBack is possible after run
+		fwd  bool // whether jumpForward is possible after run
+	}{
+		{
+			name: "push",
+			run:  func(r *router) {},
+			want: []string{"a", "b", "c"},
+			back: true,
+			fwd:  false,
+		},
+		{
+			name: "jump back",
+			run:  func(r *router) { r.jumpBack() },
+			want: []string{"a", "b"},
+			back: true,
+			fwd:  true,
+		},
+		{
+			name: "jump back and forward",
+			run: func(r *router) {
+				r.jumpBack()
+				r.jumpBack()
+				r.jumpForward()
+			},
+			want: []string{"a", "b"},
+			back: true,
+			fwd:  true,
+		},
+		{
+			name: "push after jump back truncates the forward history",
+			run: func(r *router) {
+				r.jumpBack()
+				r.jumpBack()
+				r.push(&route{page: newTestPage("d")})
+			},
+			want: []string{"a", "d"},
+			back: true,
+			fwd:  false,
+		},
+		{
+			name: "pop is recorded",
+			run:  func(r *router) { r.pop() },
+			want: []string{"a", "b"},
+			back: true,
+			fwd:  false,
+		},
+		{
+			name: "replace",
+			run:  func(r *router) { r.replace(1, &route{page: newTestPage("d")}) },
+			want: []string{"a", "d"},
+			back: true,
+			fwd:  false,
+		},
+		{
+			name: "pop to root",
+			run:  func(r *router) { r.popToRoot() },
+			want: []string{"a"},
+			back: true,
+			fwd:  false,
+		},
+	}
+	for _, test := range tests {
+		r := newTestRouter("a", "b", "c")
+		test.run(r)
+		if got := stackNames(r); notEqual(got, test.want) {
+			t.Errorf("%s: stack got: %v, want: %v", test.name, got, test.want)
+		}
+		if got := r.current > 0; notEqual(got, test.back) {
+			t.Errorf("%s: back got: %v, want: %v", test.name, got, test.back)
+		}
+		if got := r.current < len(r.history)-1; notEqual(got, test.fwd) {
+			t.Errorf("%s: forward got: %v, want: %v", test.name, got, test.fwd)
+		}
+	}
+}
+
+func TestRouterHistoryMaxLength(t *testing.T) {
+	r := newTestRouter("root")
+	for i := 0; i < routerHistoryMaxLength+10; i++ {
+		r.push(&route{page: newTestPage("p")})
+		r.pop()
+	}
+	if got := len(r.history); notEqual(got, routerHistoryMaxLength) {
+		t.Errorf("history got: %d, want: %d", got, routerHistoryMaxLength)
+	}
+	if got := r.current; notEqual(got, routerHistoryMaxLength-1) {
+		t.Errorf("current got: %d, want: %d", got, routerHistoryMaxLength-1)
+	}
+}
+
+func TestRouterPopToBreadcrumb(t *testing.T) {
+	tests := []struct {
+		name  string
+		found bool
+		want  []string
+	}{
+		{
+			// the root is not a breadcrumb to return to, since it is returned to by the title
+			name:  "a",
+			found: false,
+			want:  []string{"a", "b", "c", "d"},
+		},
+		{
+			name:  "b",
+			found: true,
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "c",
+			found: true,
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			// the current page
+			name:  "d",
+			found: false,
+			want:  []string{"a", "b", "c", "d"},
+		},
+		{
+			name:  "x",
+			found: false,
+			want:  []string{"a", "b", "c", "d"},
+		},
+	}
+	for _, test := range tests {
+		r := newTestRouter("a", "b", "c", "d")
+		if got := r.popToBreadcrumb(test.name); notEqual(got, test.found) {
+			t.Errorf("name: %s, found got: %v, want: %v", test.name, got, test.found)
+		}
+		if got := stackNames(r); notEqual(got, test.want) {
+			t.Errorf("name: %s, stack got: %v, want: %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestPageMsgToHiddenPage(t *testing.T) {
+	m := newModel(nil)
+	hidden := newTestPage("hidden")
+	m.router.push(&route{page: hidden})
+	// the result of the command is addressed to the page which returned it
+	msg := hidden.Update(nil)()
+	m.router.push(&route{page: newTestPage("top")})
+
+	tm, _ := m.Update(msg)
+	m = tm.(model)
+
+	if got := len(hidden.model.received); notEqual(got, 2) {
+		t.Errorf("hidden received got: %d, want: %d", got, 2)
+	}
+	if _, ok := hidden.model.received[1].(testLoadedMsg); !ok {
+		t.Errorf("hidden received got: %T, want: testLoadedMsg", hidden.model.received[1])
+	}
+	top := m.router.top().page.(*routedPage[testPageModel, *testPageModel])
+	if got := len(top.model.received); notEqual(got, 0) {
+		t.Errorf("top received got: %d, want: %d", got, 0)
+	}
+}
+
+func TestAddressTo(t *testing.T) {
+	p := newTestPage("p")
+	tests := []struct {
+		name string
+		cmd  tea.Cmd
+		want func(tea.Msg) bool
+	}{
+		{
+			name: "message",
+			cmd:  func() tea.Msg { return testLoadedMsg{} },
+			want: func(msg tea.Msg) bool {
+				m, ok := msg.(pageMsg)
+				return ok && m.to == page(p) && m.msg == testLoadedMsg{}
+			},
+		},
+		{
+			name: "quit",
+			cmd:  tea.Quit,
+			want: func(msg tea.Msg) bool {
+				_, ok := msg.(tea.QuitMsg)
+				return ok
+			},
+		},
+		{
+			name: "batch",
+			cmd:  tea.Batch(func() tea.Msg { return testLoadedMsg{} }, tea.Quit),
+			want: func(msg tea.Msg) bool {
+				b, ok := msg.(tea.BatchMsg)
+				if !ok || len(b) != 2 {
+					return false
+				}
+				_, ok0 := b[0]().(pageMsg)
+				_, ok1 := b[1]().(tea.QuitMsg)
+				return ok0 && ok1
+			},
+		},
+		{
+			name: "nil",
+			cmd:  func() tea.Msg { return nil },
+			want: func(msg tea.Msg) bool { return msg == nil },
+		},
+	}
+	for _, test := range tests {
+		if got := addressTo(p, test.cmd)(); !test.want(got) {
+			t.Errorf("%s: got: %#v", test.name, got)
+		}
+	}
+}
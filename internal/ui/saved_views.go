@@ -0,0 +1,303 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+)
+
+var (
+	savedViewsDialogErrorStyle = lipgloss.NewStyle().
+		Foreground(theme.Error.Color())
+)
+
+const (
+	savedViewsFileName       = "views.json"
+	savedViewsDialogMinWidth = 56
+)
+
+// savedView is a named combination of the filter, query and sort order of a list page.
+type savedView struct {
+	Name    string          `json:"name"`
+	Page    string          `json:"page"`
+	Filters []string        `json:"filters,omitempty"` // languages or statuses
+	Query   string          `json:"query,omitempty"`
+	Sort    *savedSortOrder `json:"sort,omitempty"`
+	Default bool            `json:"default,omitempty"`
+}
+
+type savedViewsFile struct {
+	Views []*savedView `json:"views"`
+}
+
+func loadSavedViews() ([]*savedView, error) {
+	var f savedViewsFile
+	if err := ghcv.LoadConfigJSON(savedViewsFileName, &f); err != nil {
+		return nil, err
+	}
+	return f.Views, nil
+}
+
+func storeSavedViews(views []*savedView) error {
+	return ghcv.StoreConfigJSON(savedViewsFileName, savedViewsFile{Views: views})
+}
+
+// defaultSavedView returns the view marked as the default of the page, or nil.
+func defaultSavedView(page string) *savedView {
+	views, err := loadSavedViews()
+	if err != nil {
+		return nil
+	}
+	for _, v := range views {
+		if v.Page == page && v.Default {
+			return v
+		}
+	}
+	return nil
+}
+
+type savedViewsDialogDelegateKeyMap struct {
+	add    key.Binding
+	def    key.Binding
+	delete key.Binding
+	save   key.Binding
+	cancel key.Binding
+}
+
+var (
+	// the cursor, enter and esc are the keys of select-dialog
+	savedViewsDialogKeys = registerKeyScope("saved-views",
+		newKeyAction("add", "a", "save current", "a"),
+		newKeyAction("default", "*", "default", "*"),
+		newKeyAction("delete", "d", "delete", "d"),
+	)
+
+	// the name of a new view is typed in this mode
+	savedViewsDialogInputKeys = registerKeyScope("saved-views-input",
+		newKeyAction("save", "enter", "save", "enter"),
+		newKeyAction("cancel", "esc", "cancel", "esc"),
+	)
+)
+
+func newSavedViewsDialogDelegateKeyMap() savedViewsDialogDelegateKeyMap {
+	return savedViewsDialogDelegateKeyMap{
+		add:    savedViewsDialogKeys.binding("add"),
+		def:    savedViewsDialogKeys.binding("default"),
+		delete: savedViewsDialogKeys.binding("delete"),
+		save:   savedViewsDialogInputKeys.binding("save"),
+		cancel: savedViewsDialogInputKeys.binding("cancel"),
+	}
+}
+
+type savedViewsAction int
+
+const (
+	savedViewsNoAction savedViewsAction = iota
+	savedViewsApplyAction
+	savedViewsSaveAction
+)
+
+// savedViewsDialog lists the saved views of a page.
+// The page applies or builds the views, the dialog only manages the file.
+type savedViewsDialog struct {
+	*selectDialog
+	page   string
+	views  []*savedView // all pages
+	keys   savedViewsDialogDelegateKeyMap
+	naming bool
+	input  textinput.Model
+	// the views cannot be changed if the file could not be loaded, not to overwrite the views in it
+	loadErr error
+	err     error
+}
+
+// newSavedViewsDialog creates a dialog, which is closed by the keys which opened it as well.
+func newSavedViewsDialog(page string, closeKeys []string) *savedViewsDialog {
+	input := textinput.New()
+	input.Prompt = "Name: "
+	input.CharLimit = 32
+	d := &savedViewsDialog{
+		selectDialog: newSelectDialog("Saved Views", closeKeys, false),
+		page:         page,
+		keys:         newSavedViewsDialogDelegateKeyMap(),
+		input:        input,
+	}
+	d.minWidth = savedViewsDialogMinWidth
+	d.empty = "No saved views"
+	d.footer = d.footerLines
+	return d
+}
+
+func (d *savedViewsDialog) open() {
+	d.views, d.loadErr = loadSavedViews()
+	d.err = nil
+	d.naming = false
+	d.updateItems()
+	d.selectDialog.open(0)
+}
+
+func (d *savedViewsDialog) pageViews() []*savedView {
+	views := make([]*savedView, 0)
+	for _, v := range d.views {
+		if v.Page == d.page {
+			views = append(views, v)
+		}
+	}
+	return views
+}
+
+func (d *savedViewsDialog) updateItems() {
+	views := d.pageViews()
+	items := make([]selectDialogItem, len(views))
+	for i, v := range views {
+		items[i] = selectDialogItem{name: v.Name}
+		if v.Default {
+			items[i].note = "(default)"
+		}
+	}
+	d.setItems(items)
+}
+
+func (d *savedViewsDialog) selected() *savedView {
+	if i := d.selectDialog.selected(); i >= 0 {
+		return d.pageViews()[i]
+	}
+	return nil
+}
+
+func (d *savedViewsDialog) name() string {
+	return strings.TrimSpace(d.input.Value())
+}
+
+// add stores the view, replacing the view of the page with the same name.
+func (d *savedViewsDialog) add(view *savedView) {
+	if d.loadErr != nil {
+		return
+	}
+	views := make([]*savedView, 0, len(d.views)+1)
+	for _, v := range d.views {
+		if v.Page == view.Page && v.Name == view.Name {
+			view.Default = v.Default
+			continue
+		}
+		views = append(views, v)
+	}
+	d.views = append(views, view)
+	d.store()
+	d.selectDialog.open(len(d.pageViews()) - 1)
+}
+
+func (d *savedViewsDialog) toggleDefault(view *savedView) {
+	if d.loadErr != nil {
+		return
+	}
+	for _, v := range d.pageViews() {
+		if v != view {
+			v.Default = false
+		}
+	}
+	view.Default = !view.Default
+	d.store()
+}
+
+func (d *savedViewsDialog) delete(view *savedView) {
+	if d.loadErr != nil {
+		return
+	}
+	views := make([]*savedView, 0, len(d.views))
+	for _, v := range d.views {
+		if v != view {
+			views = append(views, v)
+		}
+	}
+	d.views = views
+	d.store()
+}
+
+// store writes the views and shows them in the dialog.
+func (d *savedViewsDialog) store() {
+	d.err = storeSavedViews(d.views)
+	d.updateItems()
+}
+
+// update handles the message and reports what the page should do.
+// On savedViewsSaveAction, the page builds the current view and passes it to add.
+func (d *savedViewsDialog) update(msg tea.Msg) (savedViewsAction, tea.Cmd) {
+	if d.naming {
+		return d.updateNaming(msg)
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok && !d.finding {
+		switch {
+		case key.Matches(msg, d.keys.add) && d.loadErr == nil:
+			d.naming = true
+			d.input.SetValue("")
+			return savedViewsNoAction, d.input.Focus()
+		case key.Matches(msg, d.keys.def):
+			if v := d.selected(); v != nil {
+				d.toggleDefault(v)
+			}
+			return savedViewsNoAction, nil
+		case key.Matches(msg, d.keys.delete):
+			if v := d.selected(); v != nil {
+				d.delete(v)
+			}
+			return savedViewsNoAction, nil
+		}
+	}
+	if d.selectDialog.update(msg) == selectDialogEntered {
+		d.opened = false
+		return savedViewsApplyAction, nil
+	}
+	return savedViewsNoAction, nil
+}
+
+func (d *savedViewsDialog) updateNaming(msg tea.Msg) (savedViewsAction, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, d.keys.save):
+			if d.name() == "" {
+				return savedViewsNoAction, nil
+			}
+			d.naming = false
+			d.input.Blur()
+			return savedViewsSaveAction, nil
+		case key.Matches(msg, d.keys.cancel):
+			d.naming = false
+			d.input.Blur()
+			return savedViewsNoAction, nil
+		}
+	}
+	var cmd tea.Cmd
+	d.input, cmd = d.input.Update(msg)
+	return savedViewsNoAction, cmd
+}
+
+// footerLines returns the name input, the keys and the error below the views.
+func (d *savedViewsDialog) footerLines() []string {
+	lines := []string{""}
+	if d.naming {
+		lines = append(lines, d.input.View())
+		lines = append(lines, d.helpView(d.keys.save, d.keys.cancel))
+	} else {
+		lines = append(lines, d.helpView(d.selectDialog.keys.enter, d.keys.add, d.keys.def, d.keys.delete))
+	}
+	if d.loadErr != nil {
+		lines = append(lines, savedViewsDialogErrorStyle.Render("Views cannot be changed: "+d.loadErr.Error()))
+	} else if d.err != nil {
+		lines = append(lines, savedViewsDialogErrorStyle.Render(d.err.Error()))
+	}
+	return lines
+}
+
+func (d *savedViewsDialog) helpView(bindings ...key.Binding) string {
+	hs := make([]string, len(bindings))
+	for i, b := range bindings {
+		hs[i] = b.Help().Key + " " + b.Help().Desc
+	}
+	return selectDialogSubStyle.Render(strings.Join(hs, " • "))
+}
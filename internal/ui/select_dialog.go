@@ -0,0 +1,453 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/lusingander/kasane"
+)
+
+var (
+	selectDialogBodyStyle = lipgloss.NewStyle().
+				Padding(0, 2)
+
+	selectDialogStyle = lipgloss.NewStyle().
+				BorderStyle(lipgloss.RoundedBorder())
+
+	selectDialogSelectedStyle = lipgloss.NewStyle().
+					Foreground(theme.Selected.Color())
+
+	selectDialogNotSelectedStyle = lipgloss.NewStyle()
+
+	selectDialogSubStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color())
+)
+
+const (
+	selectDialogMinWidth = 30
+	// the borders, the title and the find line of the dialog, and some space around it
+	selectDialogFrameHeight = 8
+)
+
+type selectDialogItem struct {
+	name string // used for type-ahead and find
+	note string // shown after the name
+}
+
+type selectDialogKeyMap struct {
+	next   key.Binding
+	prev   key.Binding
+	enter  key.Binding
+	toggle key.Binding
+	all    key.Binding
+	none   key.Binding
+	find   key.Binding
+	close  key.Binding
+}
+
+var selectDialogKeys = registerKeyScope("select-dialog",
+	newKeyAction("down", "j", "select next", "j", "down"),
+	newKeyAction("up", "k", "select prev", "k", "up"),
+	newKeyAction("select", "enter", "select", "enter"),
+	newKeyAction("toggle", "space", "toggle", " "),
+	newKeyAction("all", "a", "select all", "a"),
+	newKeyAction("none", "n", "select none", "n"),
+	newKeyAction("find", "/", "find", "/"),
+	newKeyAction("close", "esc", "close dialog", "esc"),
+)
+
+func newSelectDialogKeyMap(closeKeys []string) selectDialogKeyMap {
+	return selectDialogKeyMap{
+		next:   selectDialogKeys.binding("down"),
+		prev:   selectDialogKeys.binding("up"),
+		enter:  selectDialogKeys.binding("select"),
+		toggle: selectDialogKeys.binding("toggle"),
+		all:    selectDialogKeys.binding("all"),
+		none:   selectDialogKeys.binding("none"),
+		find:   selectDialogKeys.binding("find"),
+		close:  selectDialogKeys.bindingWithKeys("close", closeKeys),
+	}
+}
+
+type selectDialogEvent int
+
+const (
+	selectDialogNoEvent selectDialogEvent = iota
+	// enter was pressed, or an item was clicked, in a single selection dialog
+	selectDialogEntered
+	// the checked items of a multiple selection dialog have changed
+	selectDialogChanged
+	selectDialogClosed
+	// the key was not handled by the dialog, so the owner can handle it
+	selectDialogIgnored
+)
+
+// selectDialog is an overlay dialog to pick items from a list.
+// It scrolls when the items do not fit, moves to the item whose name starts with the typed keys,
+// narrows the items by typing after `/`, and supports mouse clicks and the wheel.
+// In multiple selection mode, items are checked with space and an empty selection means "all".
+// The dialogs which embed it handle their own keys before passing the message to update.
+type selectDialog struct {
+	title  string
+	multi  bool
+	items  []selectDialogItem
+	keys   selectDialogKeyMap
+	opened bool
+
+	minWidth int
+	empty    string          // shown when there are no items
+	footer   func() []string // the lines shown below the items, if not nil
+
+	listCursor // index of visible
+	checked    map[string]bool
+
+	typed   string // the keys typed in a row for type-ahead
+	finding bool
+	find    string
+	visible []int // indexes of the items that match find
+
+	width, height int
+}
+
+// newSelectDialog creates a dialog, which is closed by the keys which opened it as well.
+func newSelectDialog(title string, closeKeys []string, multi bool) *selectDialog {
+	return &selectDialog{
+		title:    title,
+		multi:    multi,
+		keys:     newSelectDialogKeyMap(closeKeys),
+		minWidth: selectDialogMinWidth,
+		empty:    "No items",
+		checked:  make(map[string]bool),
+	}
+}
+
+func (d *selectDialog) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+	d.scroll()
+}
+
+// setItems replaces the items, keeping the checked items that still exist.
+func (d *selectDialog) setItems(items []selectDialogItem) {
+	d.items = items
+	checked := make(map[string]bool)
+	for _, item := range items {
+		if d.checked[item.name] {
+			checked[item.name] = true
+		}
+	}
+	d.checked = checked
+	d.updateVisible()
+}
+
+func (d *selectDialog) open(cursor int) {
+	d.opened = true
+	d.typed = ""
+	d.finding = false
+	d.find = ""
+	d.updateVisible()
+	d.cursor = 0
+	for i, idx := range d.visible {
+		if idx == cursor {
+			d.cursor = i
+		}
+	}
+	d.scroll()
+}
+
+// selected returns the index of the item under the cursor, or -1.
+func (d *selectDialog) selected() int {
+	if d.cursor < len(d.visible) {
+		return d.visible[d.cursor]
+	}
+	return -1
+}
+
+func (d *selectDialog) isChecked(name string) bool {
+	return d.checked[name]
+}
+
+func (d *selectDialog) setChecked(names []string) {
+	d.checked = make(map[string]bool)
+	for _, name := range names {
+		d.checked[name] = true
+	}
+	d.setItems(d.items)
+}
+
+// checkedNames returns the names of the checked items in the order of the items.
+func (d *selectDialog) checkedNames() []string {
+	names := make([]string, 0)
+	for _, item := range d.items {
+		if d.checked[item.name] {
+			names = append(names, item.name)
+		}
+	}
+	return names
+}
+
+func (d *selectDialog) updateVisible() {
+	d.visible = make([]int, 0, len(d.items))
+	find := strings.ToLower(d.find)
+	for i, item := range d.items {
+		if strings.Contains(strings.ToLower(item.name), find) {
+			d.visible = append(d.visible, i)
+		}
+	}
+	if d.cursor >= len(d.visible) {
+		d.cursor = max(len(d.visible)-1, 0)
+	}
+	d.scroll()
+}
+
+func (d *selectDialog) rows() int {
+	footer := 0
+	if f := d.footerView(d.dialogWidth()); f != "" {
+		footer = lipgloss.Height(f)
+	}
+	return max(min(len(d.visible), d.height-selectDialogFrameHeight-footer), 1)
+}
+
+// scroll keeps the cursor inside the displayed rows.
+func (d *selectDialog) scroll() {
+	d.scrollToCursor(d.rows(), len(d.visible))
+}
+
+func (d *selectDialog) move(delta int) {
+	d.listCursor.move(delta, len(d.visible), true)
+	d.scroll()
+}
+
+// typeAhead moves the cursor to the next item whose name starts with the keys typed in a row,
+// or with the key alone if none does, and reports whether one is found.
+func (d *selectDialog) typeAhead(runes []rune) bool {
+	for _, typed := range []string{d.typed + string(runes), string(runes)} {
+		// the current item is kept while it matches, and the same key again moves to the next
+		from := d.cursor
+		if typed == string(runes) {
+			from++
+		}
+		for i := range d.visible {
+			c := (from + i) % len(d.visible)
+			name := strings.ToLower(d.items[d.visible[c]].name)
+			if strings.HasPrefix(name, strings.ToLower(typed)) {
+				d.typed = typed
+				d.cursor = c
+				d.scroll()
+				return true
+			}
+		}
+	}
+	d.typed = ""
+	return false
+}
+
+func (d *selectDialog) toggle() selectDialogEvent {
+	i := d.selected()
+	if i < 0 {
+		return selectDialogNoEvent
+	}
+	name := d.items[i].name
+	if d.checked[name] {
+		delete(d.checked, name)
+	} else {
+		d.checked[name] = true
+	}
+	return selectDialogChanged
+}
+
+func (d *selectDialog) update(msg tea.Msg) selectDialogEvent {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if d.finding {
+			return d.updateFind(msg)
+		}
+		return d.updateKey(msg)
+	case tea.MouseMsg:
+		return d.updateMouse(msg)
+	}
+	return selectDialogNoEvent
+}
+
+func (d *selectDialog) updateFind(msg tea.KeyMsg) selectDialogEvent {
+	switch msg.Type {
+	case tea.KeyEsc:
+		d.finding = false
+		d.find = ""
+	case tea.KeyEnter:
+		d.finding = false
+	case tea.KeyBackspace:
+		if r := []rune(d.find); len(r) > 0 {
+			d.find = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		d.find += string(msg.Runes)
+		d.cursor = 0
+	default:
+		return selectDialogNoEvent
+	}
+	d.updateVisible()
+	return selectDialogNoEvent
+}
+
+func (d *selectDialog) updateKey(msg tea.KeyMsg) selectDialogEvent {
+	typed := d.typed
+	d.typed = ""
+	switch {
+	case key.Matches(msg, d.keys.close):
+		d.opened = false
+		return selectDialogClosed
+	case key.Matches(msg, d.keys.next):
+		d.move(1)
+	case key.Matches(msg, d.keys.prev):
+		d.move(-1)
+	case key.Matches(msg, d.keys.find):
+		d.finding = true
+	case d.multi && key.Matches(msg, d.keys.toggle):
+		return d.toggle()
+	case d.multi && key.Matches(msg, d.keys.all):
+		for _, item := range d.items {
+			d.checked[item.name] = true
+		}
+		return selectDialogChanged
+	case d.multi && key.Matches(msg, d.keys.none):
+		d.checked = make(map[string]bool)
+		return selectDialogChanged
+	case d.multi && key.Matches(msg, d.keys.enter):
+		d.opened = false
+		return selectDialogClosed
+	case key.Matches(msg, d.keys.enter):
+		if d.selected() >= 0 {
+			return selectDialogEntered
+		}
+	case msg.Type == tea.KeyRunes && !msg.Alt:
+		d.typed = typed
+		if !d.typeAhead(msg.Runes) {
+			return selectDialogIgnored
+		}
+	default:
+		return selectDialogIgnored
+	}
+	return selectDialogNoEvent
+}
+
+func (d *selectDialog) updateMouse(msg tea.MouseMsg) selectDialogEvent {
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		d.move(1)
+		return selectDialogNoEvent
+	case tea.MouseButtonWheelUp:
+		d.move(-1)
+		return selectDialogNoEvent
+	}
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+		return selectDialogNoEvent
+	}
+	top, left, w, h := d.bounds()
+	if msg.X < left || left+w <= msg.X || msg.Y < top || top+h <= msg.Y {
+		d.opened = false
+		return selectDialogClosed
+	}
+	// the top border, the title (with its bottom border) and the find line
+	row := msg.Y - top - 3
+	if d.finding || d.find != "" {
+		row--
+	}
+	if row < 0 || d.rows() <= row || len(d.visible) <= d.offset+row {
+		return selectDialogNoEvent
+	}
+	d.cursor = d.offset + row
+	if d.multi {
+		return d.toggle()
+	}
+	return selectDialogEntered
+}
+
+func (d *selectDialog) dialogView() string {
+	lines := make([]string, 0)
+	if d.finding || d.find != "" {
+		lines = append(lines, selectDialogSubStyle.Render("/"+d.find))
+	}
+	end := min(d.offset+d.rows(), len(d.visible))
+	for i := d.offset; i < end; i++ {
+		lines = append(lines, d.itemView(i))
+	}
+	if len(d.visible) == 0 {
+		lines = append(lines, selectDialogSubStyle.Render("  "+d.empty))
+	}
+	if n := len(d.visible); n > d.rows() {
+		lines = append(lines, selectDialogSubStyle.Render(fmt.Sprintf("  %d-%d of %d", d.offset+1, end, n)))
+	}
+
+	width := d.dialogWidth()
+	title := dialogTitleStyle.Copy().Width(width).Render(d.title)
+	body := selectDialogBodyStyle.Copy().Width(width).Render(strings.Join(lines, "\n"))
+	if footer := d.footerView(width); footer != "" {
+		body = lipgloss.JoinVertical(lipgloss.Left, body, footer)
+	}
+	return selectDialogStyle.Render(lipgloss.JoinVertical(lipgloss.Left, title, body))
+}
+
+func (d *selectDialog) dialogWidth() int {
+	width := d.minWidth
+	for _, item := range d.items {
+		width = max(width, lipgloss.Width(d.itemString(item, false))+6)
+	}
+	return width
+}
+
+// footerView renders the footer, wrapped in the width of the dialog.
+func (d *selectDialog) footerView(width int) string {
+	if d.footer == nil {
+		return ""
+	}
+	return selectDialogBodyStyle.Copy().Width(width).Render(strings.Join(d.footer(), "\n"))
+}
+
+func (d *selectDialog) nameWidth() int {
+	w := 0
+	for _, item := range d.items {
+		w = max(w, lipgloss.Width(item.name))
+	}
+	return w
+}
+
+// bounds returns the position and the size of the dialog on the page.
+func (d *selectDialog) bounds() (top, left, width, height int) {
+	width, height = lipgloss.Size(d.dialogView())
+	top = (d.height / 2) - (height / 2)
+	left = (d.width / 2) - (width / 2)
+	return
+}
+
+func (d *selectDialog) view(base string) string {
+	top, left, _, _ := d.bounds()
+	return kasane.OverlayString(base, d.dialogView(), top, left, kasane.WithPadding(d.width))
+}
+
+func (d *selectDialog) itemView(i int) string {
+	item := d.items[d.visible[i]]
+	s := d.itemString(item, d.checked[item.name])
+	if d.cursor == i {
+		return selectDialogSelectedStyle.Render("> " + s)
+	}
+	return selectDialogNotSelectedStyle.Render("  " + s)
+}
+
+func (d *selectDialog) itemString(item selectDialogItem, checked bool) string {
+	s := item.name
+	if item.note != "" {
+		s = fmt.Sprintf("%-*s %s", d.nameWidth(), item.name, item.note)
+	}
+	if !d.multi {
+		return s
+	}
+	if checked {
+		return "[x] " + s
+	}
+	return "[ ] " + s
+}
@@ -0,0 +1,295 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testKeyMsg returns the message of the key, named as in the keymap.
+func testKeyMsg(k string) tea.KeyMsg {
+	msg, _ := keyMsgOf(k)
+	return msg
+}
+
+func newTestSelectDialog(multi bool, names ...string) *selectDialog {
+	d := newSelectDialog("Test", nil, multi)
+	items := make([]selectDialogItem, len(names))
+	for i, name := range names {
+		items[i] = selectDialogItem{name: name}
+	}
+	d.setItems(items)
+	d.SetSize(80, 40)
+	d.open(0)
+	return d
+}
+
+func TestSelectDialogCursor(t *testing.T) {
+	tests := []struct {
+		keys []string
+		want int
+	}{
+		{
+			keys: []string{"j"},
+			want: 1,
+		},
+		{
+			keys: []string{"k"},
+			want: 3,
+		},
+		{
+			keys: []string{"j", "j", "j", "j"},
+			want: 0,
+		},
+		{
+			keys: []string{"b"},
+			want: 1,
+		},
+		{
+			keys: []string{"b", "l"},
+			want: 2,
+		},
+		{
+			keys: []string{"b", "b"},
+			want: 2,
+		},
+		{
+			keys: []string{"b", "b", "b"},
+			want: 1,
+		},
+		{
+			keys: []string{"B", "L", "U"},
+			want: 2,
+		},
+		{
+			keys: []string{"b", "c"},
+			want: 3,
+		},
+		{
+			keys: []string{"j", "x"},
+			want: 1,
+		},
+		{
+			keys: []string{"/", "r", "r", "enter"},
+			want: 0,
+		},
+		{
+			keys: []string{"/", "r", "r", "enter", "j"},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		d := newTestSelectDialog(false, "apple", "banana", "blueberry", "cherry")
+		for _, k := range test.keys {
+			d.update(testKeyMsg(k))
+		}
+		if got := d.cursor; notEqual(got, test.want) {
+			t.Errorf("keys: %v, got: %v, want: %v", test.keys, got, test.want)
+		}
+	}
+}
+
+func TestSelectDialogFind(t *testing.T) {
+	tests := []struct {
+		keys     []string
+		want     []int
+		selected int
+	}{
+		{
+			keys:     []string{"/", "b"},
+			want:     []int{1, 2},
+			selected: 1,
+		},
+		{
+			keys:     []string{"/", "R", "R"},
+			want:     []int{2, 3},
+			selected: 2,
+		},
+		{
+			keys:     []string{"/", "r", "r", "enter", "j"},
+			want:     []int{2, 3},
+			selected: 3,
+		},
+		{
+			keys:     []string{"/", "x"},
+			want:     []int{},
+			selected: -1,
+		},
+		{
+			keys:     []string{"/", "x", "backspace"},
+			want:     []int{0, 1, 2, 3},
+			selected: 0,
+		},
+		{
+			keys:     []string{"j", "/", "b", "esc"},
+			want:     []int{0, 1, 2, 3},
+			selected: 0,
+		},
+	}
+	for _, test := range tests {
+		d := newTestSelectDialog(false, "apple", "banana", "blueberry", "cherry")
+		for _, k := range test.keys {
+			d.update(testKeyMsg(k))
+		}
+		if got := d.visible; notEqual(got, test.want) {
+			t.Errorf("keys: %v, visible got: %v, want: %v", test.keys, got, test.want)
+		}
+		if got := d.selected(); notEqual(got, test.selected) {
+			t.Errorf("keys: %v, selected got: %v, want: %v", test.keys, got, test.selected)
+		}
+	}
+}
+
+func TestSelectDialogEvents(t *testing.T) {
+	tests := []struct {
+		multi   bool
+		keys    []string
+		want    selectDialogEvent
+		checked []string
+		opened  bool
+	}{
+		{
+			multi:   false,
+			keys:    []string{"j", "enter"},
+			want:    selectDialogEntered,
+			checked: []string{},
+			opened:  true,
+		},
+		{
+			multi:   false,
+			keys:    []string{"/", "x", "enter", "enter"},
+			want:    selectDialogNoEvent,
+			checked: []string{},
+			opened:  true,
+		},
+		{
+			multi:   false,
+			keys:    []string{"x"},
+			want:    selectDialogIgnored,
+			checked: []string{},
+			opened:  true,
+		},
+		{
+			multi:   false,
+			keys:    []string{"esc"},
+			want:    selectDialogClosed,
+			checked: []string{},
+			opened:  false,
+		},
+		{
+			multi:   true,
+			keys:    []string{"j", " "},
+			want:    selectDialogChanged,
+			checked: []string{"banana"},
+			opened:  true,
+		},
+		{
+			multi:   true,
+			keys:    []string{"j", " ", " "},
+			want:    selectDialogChanged,
+			checked: []string{},
+			opened:  true,
+		},
+		{
+			multi:   true,
+			keys:    []string{"a"},
+			want:    selectDialogChanged,
+			checked: []string{"apple", "banana", "cherry"},
+			opened:  true,
+		},
+		{
+			multi:   true,
+			keys:    []string{"a", "n"},
+			want:    selectDialogChanged,
+			checked: []string{},
+			opened:  true,
+		},
+		{
+			multi:   true,
+			keys:    []string{"c", " ", "enter"},
+			want:    selectDialogClosed,
+			checked: []string{"cherry"},
+			opened:  false,
+		},
+	}
+	for _, test := range tests {
+		d := newTestSelectDialog(test.multi, "apple", "banana", "cherry")
+		var got selectDialogEvent
+		for _, k := range test.keys {
+			got = d.update(testKeyMsg(k))
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("multi: %v, keys: %v, got: %v, want: %v", test.multi, test.keys, got, test.want)
+		}
+		if got := d.checkedNames(); notEqual(got, test.checked) {
+			t.Errorf("multi: %v, keys: %v, checked got: %v, want: %v", test.multi, test.keys, got, test.checked)
+		}
+		if got := d.opened; notEqual(got, test.opened) {
+			t.Errorf("multi: %v, keys: %v, opened got: %v, want: %v", test.multi, test.keys, got, test.opened)
+		}
+	}
+}
+
+func TestSelectDialogClick(t *testing.T) {
+	tests := []struct {
+		multi   bool
+		row     int // from the first item
+		want    selectDialogEvent
+		cursor  int
+		checked []string
+	}{
+		{
+			multi:   false,
+			row:     2,
+			want:    selectDialogEntered,
+			cursor:  2,
+			checked: []string{},
+		},
+		{
+			multi:   true,
+			row:     1,
+			want:    selectDialogChanged,
+			cursor:  1,
+			checked: []string{"banana"},
+		},
+		{
+			multi:   false,
+			row:     -20,
+			want:    selectDialogClosed,
+			cursor:  0,
+			checked: []string{},
+		},
+	}
+	for _, test := range tests {
+		d := newTestSelectDialog(test.multi, "apple", "banana", "cherry")
+		top, left, _, _ := d.bounds()
+		// the top border, the title and its bottom border
+		msg := tea.MouseMsg{X: left + 4, Y: top + 3 + test.row, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+		if got := d.update(msg); notEqual(got, test.want) {
+			t.Errorf("multi: %v, row: %d, got: %v, want: %v", test.multi, test.row, got, test.want)
+		}
+		if got := d.cursor; notEqual(got, test.cursor) {
+			t.Errorf("multi: %v, row: %d, cursor got: %v, want: %v", test.multi, test.row, got, test.cursor)
+		}
+		if got := d.checkedNames(); notEqual(got, test.checked) {
+			t.Errorf("multi: %v, row: %d, checked got: %v, want: %v", test.multi, test.row, got, test.checked)
+		}
+	}
+}
+
+func TestSelectDialogScroll(t *testing.T) {
+	names := make([]string, 20)
+	for i := range names {
+		names[i] = string(rune('a' + i))
+	}
+	d := newTestSelectDialog(false, names...)
+	d.SetSize(80, selectDialogFrameHeight+5)
+	d.update(testKeyMsg("k"))
+	if got, want := d.offset, 15; notEqual(got, want) {
+		t.Errorf("offset got: %v, want: %v", got, want)
+	}
+	d.update(testKeyMsg("c"))
+	if got, want := d.offset, 2; notEqual(got, want) {
+		t.Errorf("offset got: %v, want: %v", got, want)
+	}
+}
@@ -0,0 +1,256 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	noSortField = -1
+)
+
+type sortField struct {
+	name        string
+	defaultDesc bool
+	compare     func(a, b list.Item) int
+}
+
+type sortOrder struct {
+	primary       int
+	primaryDesc   bool
+	secondary     int
+	secondaryDesc bool
+}
+
+type itemSorter struct {
+	page   string
+	fields []*sortField
+	sortOrder
+}
+
+// newItemSorter creates the sorter of the page with the order chosen last time, or the default order.
+func newItemSorter(page string, fields []*sortField, defaultOrder sortOrder) *itemSorter {
+	s := &itemSorter{
+		page:      page,
+		fields:    fields,
+		sortOrder: defaultOrder,
+	}
+	s.apply(currentPageSettings().Sort[page])
+	return s
+}
+
+// save remembers the order of the page, which is stored in the file of the page settings.
+func (s *itemSorter) save() {
+	currentPageSettings().Sort[s.page] = s.export()
+	changePageSettings()
+}
+
+// selectPrimary sets the field as the primary key, or reverses the direction if it already is.
+func (s *itemSorter) selectPrimary(i int) {
+	if s.primary == i {
+		s.primaryDesc = !s.primaryDesc
+	} else {
+		s.primary = i
+		s.primaryDesc = s.fields[i].defaultDesc
+	}
+	if s.secondary == i {
+		s.secondary = noSortField
+	}
+	s.save()
+}
+
+// cycleSecondary switches the tie-break key of the field: none -> default direction -> reverse -> none.
+func (s *itemSorter) cycleSecondary(i int) {
+	if s.primary == i {
+		return
+	}
+	switch {
+	case s.secondary != i:
+		s.secondary = i
+		s.secondaryDesc = s.fields[i].defaultDesc
+	case s.secondaryDesc == s.fields[i].defaultDesc:
+		s.secondaryDesc = !s.secondaryDesc
+	default:
+		s.secondary = noSortField
+	}
+	s.save()
+}
+
+// savedSortOrder is a sortOrder that refers to the fields by name, so that it can be stored in a file.
+type savedSortOrder struct {
+	Primary       string `json:"primary"`
+	PrimaryDesc   bool   `json:"primary_desc"`
+	Secondary     string `json:"secondary,omitempty"`
+	SecondaryDesc bool   `json:"secondary_desc,omitempty"`
+}
+
+func (s *itemSorter) export() *savedSortOrder {
+	o := &savedSortOrder{
+		Primary:     s.fields[s.primary].name,
+		PrimaryDesc: s.primaryDesc,
+	}
+	if s.secondary != noSortField {
+		o.Secondary = s.fields[s.secondary].name
+		o.SecondaryDesc = s.secondaryDesc
+	}
+	return o
+}
+
+// restore applies the saved order and remembers it. Unknown field names are ignored.
+func (s *itemSorter) restore(o *savedSortOrder) {
+	if o == nil {
+		return
+	}
+	s.apply(o)
+	s.save()
+}
+
+func (s *itemSorter) apply(o *savedSortOrder) {
+	if o == nil {
+		return
+	}
+	if i := s.fieldIndex(o.Primary); i != noSortField {
+		s.primary = i
+		s.primaryDesc = o.PrimaryDesc
+		s.secondary = noSortField
+	}
+	if i := s.fieldIndex(o.Secondary); i != noSortField && i != s.primary {
+		s.secondary = i
+		s.secondaryDesc = o.SecondaryDesc
+	}
+}
+
+func (s *itemSorter) fieldIndex(name string) int {
+	for i, f := range s.fields {
+		if f.name == name {
+			return i
+		}
+	}
+	return noSortField
+}
+
+func (s *itemSorter) sort(items []list.Item) []list.Item {
+	sorted := make([]list.Item, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return s.compare(sorted[i], sorted[j]) < 0
+	})
+	return sorted
+}
+
+func (s *itemSorter) compare(a, b list.Item) int {
+	if c := compareBy(s.fields[s.primary], s.primaryDesc, a, b); c != 0 {
+		return c
+	}
+	if s.secondary == noSortField {
+		return 0
+	}
+	return compareBy(s.fields[s.secondary], s.secondaryDesc, a, b)
+}
+
+func compareBy(f *sortField, desc bool, a, b list.Item) int {
+	c := f.compare(a, b)
+	if desc {
+		return -c
+	}
+	return c
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareTime(a, b time.Time) int {
+	return a.Compare(b)
+}
+
+func compareString(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+type sortDialogDelegateKeyMap struct {
+	tie key.Binding
+}
+
+var sortDialogKeys = registerKeyScope("sort-dialog",
+	newKeyAction("tie-break", "t", "tie-break by", "t"),
+).with("select-dialog")
+
+func newSortDialogDelegateKeyMap() sortDialogDelegateKeyMap {
+	return sortDialogDelegateKeyMap{
+		tie: sortDialogKeys.binding("tie-break"),
+	}
+}
+
+// sortDialog selects the primary key with enter (enter again to reverse) and the tie-break key with t.
+type sortDialog struct {
+	*selectDialog
+	sorter *itemSorter
+	keys   sortDialogDelegateKeyMap
+}
+
+func newSortDialog(sorter *itemSorter, closeKeys []string) *sortDialog {
+	d := &sortDialog{
+		selectDialog: newSelectDialog("Sort", closeKeys, false),
+		sorter:       sorter,
+		keys:         newSortDialogDelegateKeyMap(),
+	}
+	d.updateItems()
+	return d
+}
+
+func (d *sortDialog) open() {
+	d.updateItems()
+	d.selectDialog.open(d.sorter.primary)
+}
+
+// update handles the message and reports whether the sort order has changed.
+func (d *sortDialog) update(msg tea.Msg) bool {
+	if msg, ok := msg.(tea.KeyMsg); ok && !d.finding && key.Matches(msg, d.keys.tie) {
+		// before the dialog, which would take the key for type-ahead
+		if d.selected() < 0 {
+			return false
+		}
+		d.sorter.cycleSecondary(d.selected())
+	} else if d.selectDialog.update(msg) == selectDialogEntered {
+		d.sorter.selectPrimary(d.selected())
+	} else {
+		return false
+	}
+	d.updateItems()
+	return true
+}
+
+func (d *sortDialog) updateItems() {
+	items := make([]selectDialogItem, len(d.sorter.fields))
+	for i, f := range d.sorter.fields {
+		order := " "
+		switch i {
+		case d.sorter.primary:
+			order = directionMark(d.sorter.primaryDesc)
+		case d.sorter.secondary:
+			order = directionMark(d.sorter.secondaryDesc) + " (tie-break)"
+		}
+		items[i] = selectDialogItem{name: f.name, note: order}
+	}
+	d.setItems(items)
+}
+
+func directionMark(desc bool) string {
+	if desc {
+		return "↓"
+	}
+	return "↑"
+}
@@ -0,0 +1,312 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+const (
+	tableColumnSpacing  = 1
+	tableResizeStep     = 2
+	tableColumnMinWidth = 3
+)
+
+var (
+	tableHeaderStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color()).
+				Bold(true)
+
+	tableSelectedHeaderStyle = tableHeaderStyle.Copy().
+					Foreground(theme.Selected.Color()).
+					Underline(true)
+)
+
+type tableColumn struct {
+	title  string
+	width  int
+	flex   bool // takes the width left by the other columns
+	align  lipgloss.Position
+	sortBy string // the name of the field of the sorter, or empty if the column is not sortable
+	value  func(item list.Item) string
+}
+
+// savedTableLayout is the table of a page, which refers to the columns by title so that it can be stored in a file.
+type savedTableLayout struct {
+	Enabled bool           `json:"enabled"`
+	Widths  map[string]int `json:"widths,omitempty"`
+}
+
+type tableKeyMap struct {
+	toggle   key.Binding
+	next     key.Binding
+	prev     key.Binding
+	wider    key.Binding
+	narrower key.Binding
+	sort     key.Binding
+}
+
+var tableKeys = registerKeyScope("table",
+	newKeyAction("toggle", "t", "toggle table", "t"),
+	newKeyAction("next", "</>", "select column", ">").pairedWith("prev"),
+	newKeyAction("prev", "<", "select previous column", "<"),
+	newKeyAction("wider", "+/-", "resize column", "+", "=").pairedWith("narrower"),
+	newKeyAction("narrower", "-", "narrow column", "-"),
+	newKeyAction("sort", "s", "sort by column", "s"),
+)
+
+func newTableKeyMap() tableKeyMap {
+	return tableKeyMap{
+		toggle:   tableKeys.binding("toggle"),
+		next:     tableKeys.binding("next"),
+		prev:     tableKeys.binding("prev"),
+		wider:    tableKeys.binding("wider"),
+		narrower: tableKeys.binding("narrower"),
+		sort:     tableKeys.binding("sort"),
+	}
+}
+
+// table shows the items of a list in a line each, with aligned columns.
+type table struct {
+	page     string
+	enabled  bool
+	columns  []*tableColumn
+	selected int
+	sorter   *itemSorter
+	width    int
+}
+
+func newTable(page string, columns []*tableColumn, sorter *itemSorter) *table {
+	t := &table{
+		page:    page,
+		columns: columns,
+		sorter:  sorter,
+	}
+	// unknown columns are ignored
+	if l, ok := currentPageSettings().Tables[page]; ok {
+		t.enabled = l.Enabled
+		for _, c := range columns {
+			if w, ok := l.Widths[c.title]; ok && !c.flex && w >= tableColumnMinWidth {
+				c.width = w
+			}
+		}
+	}
+	return t
+}
+
+func (t *table) save() {
+	widths := make(map[string]int, len(t.columns))
+	for _, c := range t.columns {
+		if !c.flex {
+			widths[c.title] = c.width
+		}
+	}
+	currentPageSettings().Tables[t.page] = &savedTableLayout{t.enabled, widths}
+	changePageSettings()
+}
+
+// SetWidth sets the width of the rows without the padding of the list.
+func (t *table) SetWidth(width int) {
+	t.width = width
+}
+
+func (t *table) widths() []int {
+	ws := make([]int, len(t.columns))
+	rest := t.width - tableColumnSpacing*(len(t.columns)-1)
+	for i, c := range t.columns {
+		if !c.flex {
+			ws[i] = c.width
+			rest -= c.width
+		}
+	}
+	for i, c := range t.columns {
+		if c.flex {
+			ws[i] = max(rest, tableColumnMinWidth)
+		}
+	}
+	return ws
+}
+
+// updateKey handles the keys of the table, and reports whether the key is handled and the sort order is changed.
+func (t *table) updateKey(msg tea.KeyMsg, keys tableKeyMap) (handled, sorted bool) {
+	if key.Matches(msg, keys.toggle) {
+		t.enabled = !t.enabled
+		t.save()
+		return true, false
+	}
+	if !t.enabled {
+		return false, false
+	}
+	n := len(t.columns)
+	switch {
+	case key.Matches(msg, keys.next):
+		t.selected = (t.selected + 1) % n
+	case key.Matches(msg, keys.prev):
+		t.selected = (t.selected - 1 + n) % n
+	case key.Matches(msg, keys.wider):
+		t.resize(tableResizeStep)
+	case key.Matches(msg, keys.narrower):
+		t.resize(-tableResizeStep)
+	case key.Matches(msg, keys.sort):
+		return true, t.sortBy(t.selected)
+	default:
+		return false, false
+	}
+	return true, false
+}
+
+func (t *table) resize(d int) {
+	c := t.columns[t.selected]
+	if c.flex {
+		// the width follows the other columns
+		return
+	}
+	c.width = max(c.width+d, tableColumnMinWidth)
+	t.save()
+}
+
+// sortBy sorts by the column, or reverses the order if it is already sorted by the column.
+// It reports whether the sort order is changed.
+func (t *table) sortBy(i int) bool {
+	if i < 0 {
+		return false
+	}
+	f := t.sorter.fieldIndex(t.columns[i].sortBy)
+	if f == noSortField {
+		return false
+	}
+	t.selected = i
+	t.sorter.selectPrimary(f)
+	return true
+}
+
+// listDelegate returns the delegate of the list for the mode of the table.
+func (t *table) listDelegate(base helpDelegate, keys tableKeyMap) list.ItemDelegate {
+	if t.enabled {
+		return newTableDelegate(base, t, keys)
+	}
+	return base
+}
+
+// clickHeader sorts by the column clicked on the header at the line y of the page, and reports whether the sort order is changed.
+func (t *table) clickHeader(msg tea.MouseMsg, y int) bool {
+	if !t.enabled || msg.Y != y || msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionPress {
+		return false
+	}
+	return t.sortBy(t.columnAt(msg.X - listNormalItemStyle.GetPaddingLeft()))
+}
+
+// columnAt returns the index of the column at the position x of the row, or -1.
+func (t *table) columnAt(x int) int {
+	left := 0
+	for i, w := range t.widths() {
+		if left <= x && x < left+w {
+			return i
+		}
+		left += w + tableColumnSpacing
+	}
+	return -1
+}
+
+func (t *table) headerView() string {
+	ws := t.widths()
+	cells := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		title := c.title
+		if f := t.sorter.fieldIndex(c.sortBy); f != noSortField && f == t.sorter.primary {
+			title += " " + directionMark(t.sorter.primaryDesc)
+		}
+		title = truncate.StringWithTail(title, uint(ws[i]), ellipsis)
+		title = lipgloss.PlaceHorizontal(ws[i], c.align, title)
+		if i == t.selected {
+			cells[i] = tableSelectedHeaderStyle.Render(title)
+		} else {
+			cells[i] = tableHeaderStyle.Render(title)
+		}
+	}
+	header := truncate.String(strings.Join(cells, strings.Repeat(" ", tableColumnSpacing)), uint(t.width))
+	return listNormalItemStyle.Render(header)
+}
+
+func (t *table) rowView(item list.Item) string {
+	ws := t.widths()
+	cells := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		value := truncate.StringWithTail(c.value(item), uint(ws[i]), ellipsis)
+		cells[i] = lipgloss.PlaceHorizontal(ws[i], c.align, value)
+	}
+	// the columns may not fit in a narrow list
+	return truncate.String(strings.Join(cells, strings.Repeat(" ", tableColumnSpacing)), uint(t.width))
+}
+
+// tableListView renders the list with the header of the table above the items.
+func tableListView(l list.Model, t *table) string {
+	if l.FilterState() == list.FilterApplied {
+		l.SetShowStatusBar(true)
+	}
+	// the first line is the filter input
+	bar, items, _ := strings.Cut(l.View(), "\n")
+	// the top margin is not rendered by the style, since the rows fill the width and the margin line follows the title
+	return "\n" + bar + "\n" + t.headerView() + "\n" + items
+}
+
+// helpDelegate is a delegate which shows the help of the keys, as the delegates of the pages do.
+type helpDelegate interface {
+	list.ItemDelegate
+	help.KeyMap
+}
+
+// tableDelegate renders the items as the rows of the table, and shows the help of the delegate of the page.
+type tableDelegate struct {
+	base  helpDelegate
+	table *table
+	keys  tableKeyMap
+}
+
+var _ list.ItemDelegate = (*tableDelegate)(nil)
+
+func newTableDelegate(base helpDelegate, t *table, keys tableKeyMap) tableDelegate {
+	return tableDelegate{
+		base:  base,
+		table: t,
+		keys:  keys,
+	}
+}
+
+func (d tableDelegate) Height() int {
+	return 1
+}
+
+func (d tableDelegate) Spacing() int {
+	return 0
+}
+
+func (d tableDelegate) Update(msg tea.Msg, m *list.Model) tea.Cmd {
+	return nil
+}
+
+func (d tableDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	row := d.table.rowView(item)
+	if index == m.Index() {
+		row = listSelectedItemStyle.Render(listSelectedTitleColorStyle.Render(row))
+	} else {
+		row = listNormalItemStyle.Render(listNormalTitleColorStyle.Render(row))
+	}
+	fmt.Fprint(w, row)
+}
+
+func (d tableDelegate) ShortHelp() []key.Binding {
+	return d.base.ShortHelp()
+}
+
+func (d tableDelegate) FullHelp() [][]key.Binding {
+	return append(d.base.FullHelp(), []key.Binding{d.keys.next, d.keys.wider, d.keys.sort})
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+)
+
+// filterList types the text into the filter of the list and accepts it, as the user does.
+func filterList(l *list.Model, text string) {
+	// the blink of the cursor is not waited for
+	l.FilterInput.Cursor.SetMode(cursor.CursorStatic)
+	keys := append(append([]string{"/"}, strings.Split(text, "")...), "enter")
+	for _, k := range keys {
+		var cmd tea.Cmd
+		*l, cmd = l.Update(testKeyMsg(k))
+		for _, msg := range runTestCmd(cmd) {
+			if msg, ok := msg.(list.FilterMatchesMsg); ok {
+				*l, _ = l.Update(msg)
+			}
+		}
+	}
+}
+
+// runTestCmd runs the command and the commands batched in it, and returns their messages.
+func runTestCmd(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	msgs := make([]tea.Msg, 0)
+	for _, c := range batch {
+		msgs = append(msgs, runTestCmd(c)...)
+	}
+	return msgs
+}
+
+func TestTableListViewFilterApplied(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	repos := &gh.UserRepositories{
+		TotalCount: 2,
+		Repositories: []*gh.UserRepository{
+			{Name: "foo", Url: "https://github.com/bar/foo"},
+			{Name: "qux", Url: "https://github.com/bar/qux"},
+		},
+	}
+	s := spinner.New()
+	m := newRepositoriesModel(nil, &s)
+	m.SetSize(80, 24)
+	m, _ = m.Update(repositoriesSuccessMsg{repos})
+	m, _ = m.Update(testKeyMsg("t"))
+
+	if got := tableListView(m.list, m.table); strings.Contains(got, "2 items") {
+		t.Errorf("the status bar is shown without the filter")
+	}
+
+	filterList(&m.list, "foo")
+	if got, want := m.list.FilterState(), list.FilterApplied; notEqual(got, want) {
+		t.Fatalf("filter state got: %v, want: %v", got, want)
+	}
+	got := tableListView(m.list, m.table)
+	if !strings.Contains(got, "“foo” 1 item") {
+		t.Errorf("the status bar is not shown with the filter: %q", got)
+	}
+	// the status bar is shown only while the filter is applied
+	if m.list.ShowStatusBar() {
+		t.Errorf("the status bar of the list is changed")
+	}
+}
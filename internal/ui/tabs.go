@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+const (
+	// the tab bar is a line between the title and the page
+	tabBarHeight = 1
+)
+
+var (
+	tabBarStyle = lipgloss.NewStyle().
+			Padding(0, 0, 0, 1)
+
+	tabStyle = lipgloss.NewStyle().
+			Foreground(theme.Muted.Color()).
+			Padding(0, 1)
+
+	tabSelectedStyle = tabStyle.Copy().
+				Foreground(theme.Selected.Color()).
+				Bold(true)
+)
+
+// userSection is a page of the user listed on the tab bar.
+// The page of each section is kept per user, so that switching the sections does not reload them.
+type userSection struct {
+	title    string
+	msgType  reflect.Type
+	open     func(id string) tea.Cmd
+	userOnly bool // the page queries a user, so it is not available for an organization
+}
+
+func newUserSection[M tea.Msg](title string, open func(id string) tea.Cmd) *userSection {
+	var msg M
+	return &userSection{
+		title:   title,
+		msgType: reflect.TypeOf(msg),
+		open:    open,
+	}
+}
+
+func (s *userSection) forUsersOnly() *userSection {
+	s.userOnly = true
+	return s
+}
+
+// userSections are switched by the number keys in this order.
+var userSections = []*userSection{
+	newUserSection[selectProfilePageMsg]("Profile", selectProfilePage),
+	newUserSection[selectPullRequestsPageMsg]("PRs", selectPullRequestsPage).forUsersOnly(),
+	newUserSection[selectPullRequestStatsPageMsg]("PR Stats", selectPullRequestStatsPage).forUsersOnly(),
+	newUserSection[selectRepositoriesPageMsg]("Repositories", selectRepositoriesPage),
+	newUserSection[selectRepositoryStatsPageMsg]("Repo Stats", selectRepositoryStatsPage),
+	newUserSection[selectTimelinePageMsg]("Timeline", selectTimelinePage).forUsersOnly(),
+	newUserSection[selectFollowsPageMsg]("Follows", func(id string) tea.Cmd {
+		return selectFollowsPage(id, followersKind)
+	}).forUsersOnly(),
+}
+
+// userSectionsOf returns the sections available for the user, or for the organization.
+func userSectionsOf(organization bool) []*userSection {
+	if !organization {
+		return userSections
+	}
+	sections := make([]*userSection, 0, len(userSections))
+	for _, s := range userSections {
+		if !s.userOnly {
+			sections = append(sections, s)
+		}
+	}
+	return sections
+}
+
+// findUserSection returns the section whose page is opened by the message, or nil.
+func findUserSection(msg tea.Msg) *userSection {
+	t := reflect.TypeOf(msg)
+	for _, s := range userSections {
+		if s.msgType == t {
+			return s
+		}
+	}
+	return nil
+}
+
+// sectionKeyActions switch to the sections by their positions on the tab bar, 1 to the first one and so on.
+func sectionKeyActions() []*keyAction {
+	actions := make([]*keyAction, len(userSections))
+	for i := range actions {
+		n := strconv.Itoa(i + 1)
+		actions[i] = newKeyAction("section-"+n, n, "go to section "+n, n)
+	}
+	return actions
+}
+
+func sectionKeyBindings() []key.Binding {
+	bindings := make([]key.Binding, len(userSections))
+	for i := range bindings {
+		bindings[i] = appKeys.binding("section-" + strconv.Itoa(i+1))
+	}
+	return bindings
+}
+
+// userSectionOfKey returns the section switched by the key, or nil.
+func userSectionOfKey(msg tea.KeyMsg, sections []*userSection, keys []key.Binding) *userSection {
+	for i, s := range sections {
+		if key.Matches(msg, keys[i]) {
+			return s
+		}
+	}
+	return nil
+}
+
+func tabLabels(active *userSection, sections []*userSection, keys []key.Binding) []string {
+	labels := make([]string, len(sections))
+	for i, s := range sections {
+		label := keys[i].Help().Key + " " + s.title
+		if s == active && theme.Monochrome() {
+			// the same width as the padding
+			labels[i] = tabSelectedStyle.Copy().Padding(0).Render("[" + label + "]")
+		} else if s == active {
+			labels[i] = tabSelectedStyle.Render(label)
+		} else {
+			labels[i] = tabStyle.Render(label)
+		}
+	}
+	return labels
+}
+
+func tabBarView(active *userSection, sections []*userSection, keys []key.Binding, width int) string {
+	bar := tabBarStyle.Render(strings.Join(tabLabels(active, sections, keys), ""))
+	return truncate.String(bar, uint(max(width, 0)))
+}
+
+// tabAt returns the section of the tab at the column x of the tab bar, or nil.
+func tabAt(x int, sections []*userSection, keys []key.Binding) *userSection {
+	left := tabBarStyle.GetPaddingLeft()
+	for i, label := range tabLabels(nil, sections, keys) {
+		w := lipgloss.Width(label)
+		if left <= x && x < left+w {
+			return sections[i]
+		}
+		left += w
+	}
+	return nil
+}
+
+// withTabBar inserts the tab bar below the title, which is the first line of the page view.
+func withTabBar(view, tabBar string) string {
+	if view == "" {
+		return view
+	}
+	i := strings.Index(view, "\n")
+	if i < 0 {
+		return view + "\n" + tabBar
+	}
+	return view[:i+1] + tabBar + view[i:]
+}
@@ -0,0 +1,30 @@
+package ui
+
+import (
+	"testing"
+)
+
+func TestUserSectionsOf(t *testing.T) {
+	tests := []struct {
+		organization bool
+		want         []string
+	}{
+		{
+			organization: false,
+			want:         []string{"Profile", "PRs", "PR Stats", "Repositories", "Repo Stats", "Timeline", "Follows"},
+		},
+		{
+			organization: true,
+			want:         []string{"Profile", "Repositories", "Repo Stats"},
+		},
+	}
+	for _, test := range tests {
+		got := make([]string, 0)
+		for _, s := range userSectionsOf(test.organization) {
+			got = append(got, s.title)
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("organization: %v, got: %v, want: %v", test.organization, got, test.want)
+		}
+	}
+}
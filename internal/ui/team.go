@@ -0,0 +1,425 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	teamErrorStyle = lipgloss.NewStyle().
+			Padding(2, 0, 0, 2).
+			Foreground(theme.Error.Color())
+
+	teamTableStyle = lipgloss.NewStyle().
+			Padding(1, 0, 0, 2)
+
+	teamHeaderStyle = lipgloss.NewStyle().
+			Foreground(theme.Dimmed.Color())
+
+	teamSelectedStyle = lipgloss.NewStyle().
+				Foreground(theme.Selected.Color())
+
+	teamNameStyle = lipgloss.NewStyle().
+			Foreground(theme.Dimmed.Color())
+
+	teamMemberErrorStyle = lipgloss.NewStyle().
+				Foreground(theme.Error.Color())
+)
+
+const (
+	teamsFileName = "teams.json"
+)
+
+// team is a group of users shown in the team dashboard.
+// The members are the logins listed in Members and the members of OrgTeam (org/team-slug).
+type team struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members,omitempty"`
+	OrgTeam string   `json:"org_team,omitempty"`
+}
+
+type teamsFile struct {
+	Teams []*team `json:"teams"`
+}
+
+func loadTeams() ([]*team, error) {
+	var f teamsFile
+	if err := ghcv.LoadConfigJSON(teamsFileName, &f); err != nil {
+		return nil, err
+	}
+	return f.Teams, nil
+}
+
+type teamKeyMap struct {
+	Down   key.Binding
+	Up     key.Binding
+	Select key.Binding
+	Reload key.Binding
+	Back   key.Binding
+	Quit   key.Binding
+}
+
+var teamKeys = registerKeyScope("team",
+	newKeyAction("down", "↓/j", "down", "j", "down"),
+	newKeyAction("up", "↑/k", "up", "k", "up"),
+	newKeyAction("select", "enter", "select member", "enter"),
+	newKeyAction("reload", "r", "reload", "r"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+func (k teamKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Down,
+		k.Up,
+		k.Select,
+		k.Reload,
+		k.Back,
+		k.Quit,
+	}
+}
+
+func (k teamKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			k.Down,
+			k.Up,
+		},
+		{
+			k.Select,
+			k.Reload,
+		},
+		{
+			k.Back,
+			k.Quit,
+		},
+	}
+}
+
+type teamModel struct {
+	client *gh.GitHubClient
+
+	keys    teamKeyMap
+	help    help.Model
+	spinner *spinner.Model
+
+	team    *team
+	members []*gh.TeamMember
+	listCursor
+
+	errorMsg      *teamErrorMsg
+	loading       bool
+	width, height int
+}
+
+func newTeamModel(client *gh.GitHubClient, s *spinner.Model) teamModel {
+	keys := teamKeyMap{
+		Down:   teamKeys.binding("down"),
+		Up:     teamKeys.binding("up"),
+		Select: teamKeys.binding("select"),
+		Reload: teamKeys.binding("reload"),
+		Back:   teamKeys.binding("back"),
+		Quit:   teamKeys.binding("quit"),
+	}
+	return teamModel{
+		client:  client,
+		keys:    keys,
+		help:    help.New(),
+		spinner: s,
+	}
+}
+
+func init() {
+	registerPage(func(ctx *pageContext, _ selectTeamPageMsg) page {
+		return newRoutedPage(newTeamModel(ctx.client, ctx.spinner))
+	})
+}
+
+func (m *teamModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	m.scroll()
+}
+
+func (m *teamModel) visibleRows() int {
+	t, _, b, _ := teamTableStyle.GetPadding()
+	// title (2), header (1) and help (2)
+	return max(m.height-5-t-b, 1)
+}
+
+// rowsTop returns the line of the first row of the table, below the title and the header.
+func (m teamModel) rowsTop() int {
+	t, _, _, _ := teamTableStyle.GetPadding()
+	return 1 + t + 1
+}
+
+func (m *teamModel) scroll() {
+	m.scrollToCursor(m.visibleRows(), len(m.members))
+}
+
+func (m *teamModel) move(delta int) {
+	m.listCursor.move(delta, len(m.members), false)
+	m.scroll()
+}
+
+func (m teamModel) Init() tea.Cmd {
+	return nil
+}
+
+type teamSuccessMsg struct {
+	members []*gh.TeamMember
+}
+
+var _ tea.Msg = (*teamSuccessMsg)(nil)
+
+type teamErrorMsg struct {
+	e       error
+	summary string
+}
+
+var _ tea.Msg = (*teamErrorMsg)(nil)
+
+// logins returns the members of the team without duplicates, fetching the members of the org team.
+func (m teamModel) logins(t *team) ([]string, *teamErrorMsg) {
+	logins := append([]string{}, t.Members...)
+	if t.OrgTeam != "" {
+		org, slug, ok := strings.Cut(t.OrgTeam, "/")
+		if !ok {
+			return nil, &teamErrorMsg{nil, "invalid org_team (must be org/team-slug): " + t.OrgTeam}
+		}
+		members, err := m.client.QueryOrganizationTeamMembers(org, slug)
+		if err != nil {
+			return nil, &teamErrorMsg{err, fmt.Sprintf("failed to fetch members of %s: %v", t.OrgTeam, err)}
+		}
+		if len(members) == 0 {
+			return nil, &teamErrorMsg{nil, "no visible members in " + t.OrgTeam}
+		}
+		logins = append(logins, members...)
+	}
+	ret := make([]string, 0, len(logins))
+	seen := make(map[string]bool)
+	for _, login := range logins {
+		if l := strings.ToLower(login); !seen[l] {
+			seen[l] = true
+			ret = append(ret, login)
+		}
+	}
+	return ret, nil
+}
+
+func (m teamModel) loadTeam(t *team) tea.Cmd {
+	return func() tea.Msg {
+		logins, errMsg := m.logins(t)
+		if errMsg != nil {
+			return *errMsg
+		}
+		members, err := m.client.QueryTeamMembers(logins)
+		if err != nil {
+			return teamErrorMsg{err, "failed to fetch team members"}
+		}
+		return teamSuccessMsg{members}
+	}
+}
+
+func (m teamModel) Update(msg tea.Msg) (teamModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.Down):
+			m.move(1)
+		case key.Matches(msg, m.keys.Up):
+			m.move(-1)
+		case key.Matches(msg, m.keys.Select):
+			if m.cursor < len(m.members) {
+				return m, userSelected(m.members[m.cursor].Login)
+			}
+		case key.Matches(msg, m.keys.Reload):
+			m.loading = true
+			return m, m.loadTeam(m.team)
+		case key.Matches(msg, m.keys.Back):
+			return m, goBackUserSelectPage
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case tea.MouseMsg:
+		if m.loading || m.errorMsg != nil {
+			return m, nil
+		}
+		if d := wheelDelta(msg); d != 0 {
+			m.move(d)
+			return m, nil
+		}
+		if row := clickedRow(msg, m.rowsTop()); 0 <= row && row < m.visibleRows() {
+			i := m.offset + row
+			if i == m.cursor {
+				return m.Update(keyPress(m.keys.Select))
+			}
+			if i < len(m.members) {
+				m.move(i - m.cursor)
+			}
+		}
+		return m, nil
+	case selectTeamPageMsg:
+		m.team = msg.team
+		m.members = nil
+		m.reset()
+		m.loading = true
+		return m, m.loadTeam(msg.team)
+	case teamSuccessMsg:
+		m.errorMsg = nil
+		m.loading = false
+		m.members = msg.members
+		m.move(0)
+		return m, nil
+	case teamErrorMsg:
+		m.errorMsg = &msg
+		m.loading = false
+		return m, nil
+	}
+	return m, nil
+}
+
+func (m teamModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m teamModel) yankTargets() (*yankTarget, []*yankTarget) {
+	if m.loading || m.errorMsg != nil {
+		return nil, nil
+	}
+	var selected *yankTarget
+	visible := make([]*yankTarget, len(m.members))
+	for i, member := range m.members {
+		visible[i] = userYankTarget(member.Login)
+		if i == m.cursor {
+			selected = visible[i]
+		}
+	}
+	return selected, visible
+}
+
+func (m teamModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
+	}
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	return m.teamView()
+}
+
+func (m teamModel) teamView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	table := teamTableStyle.Render(m.tableView())
+	ret += table
+	height -= cn(table)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", max(height, 0))
+	ret += help
+
+	return ret
+}
+
+func (m teamModel) tableView() string {
+	if len(m.members) == 0 {
+		return "No members"
+	}
+	_, r, _, l := teamTableStyle.GetPadding()
+	width := m.width - r - l
+
+	loginWidth := len("Member")
+	for _, member := range m.members {
+		loginWidth = max(loginWidth, lipgloss.Width(member.Login))
+	}
+	row := func(login, merged, open, pushed, activity string) string {
+		return fmt.Sprintf("  %-*s  %10s  %4s  %11s  %s", loginWidth, login, merged, open, pushed, activity)
+	}
+
+	lines := []string{teamHeaderStyle.Render(row("Member", "Merged 30d", "Open", "Pushed (mo)", "Activity (26 weeks)"))}
+	end := min(m.offset+m.visibleRows(), len(m.members))
+	for i := m.offset; i < end; i++ {
+		member := m.members[i]
+		var line string
+		if member.Err != nil {
+			// the members which could not be fetched are shown with the error, not to hide the others
+			line = fmt.Sprintf("  %-*s  %s", loginWidth, member.Login, teamMemberErrorStyle.Render(member.Err.Error()))
+		} else {
+			line = row(
+				member.Login,
+				fmt.Sprint(member.MergedPullRequests),
+				fmt.Sprint(member.OpenPullRequests),
+				fmt.Sprint(member.PushedRepositories),
+				sparklineView(member.Contributions),
+			)
+			if member.Name != "" {
+				line += "  " + teamNameStyle.Render(member.Name)
+			}
+		}
+		line = truncate.StringWithTail(line, uint(width), "…")
+		if i == m.cursor {
+			line = teamSelectedStyle.Render(">") + line[1:]
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m teamModel) errorView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	errorText := teamErrorStyle.Render("ERROR: " + m.errorMsg.summary)
+	ret += errorText
+	height -= cn(errorText)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m teamModel) breadcrumb() []string {
+	name := ""
+	if m.team != nil {
+		name = m.team.Name
+	}
+	return []string{"Team", name}
+}
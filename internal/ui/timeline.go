@@ -0,0 +1,509 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
+	"github.com/lusingander/ghcv-cli/internal/timeline"
+	"github.com/muesli/reflow/truncate"
+)
+
+var (
+	timelineErrorStyle = lipgloss.NewStyle().
+				Padding(2, 0, 0, 2).
+				Foreground(theme.Error.Color())
+
+	timelineStyle = lipgloss.NewStyle().
+			Padding(1, 0, 0, 2)
+
+	timelineMonthStyle = lipgloss.NewStyle().
+				Bold(true)
+
+	timelineDateStyle = lipgloss.NewStyle().
+				Foreground(theme.Dimmed.Color())
+
+	timelineSelectedStyle = lipgloss.NewStyle().
+				Foreground(theme.Selected.Color())
+
+	timelineRepositoryIconStyle = lipgloss.NewStyle().
+					Foreground(theme.Link.Color())
+)
+
+type timelineKeyMap struct {
+	Down      key.Binding
+	Up        key.Binding
+	NextMonth key.Binding
+	PrevMonth key.Binding
+	Top       key.Binding
+	Bottom    key.Binding
+	Jump      key.Binding
+	Open      key.Binding
+	Back      key.Binding
+	Quit      key.Binding
+}
+
+var timelineKeys = registerKeyScope("timeline",
+	newKeyAction("down", "↓/j", "down", "j", "down"),
+	newKeyAction("up", "↑/k", "up", "k", "up"),
+	newKeyAction("next-month", "]", "older month", "]"),
+	newKeyAction("prev-month", "[", "newer month", "["),
+	newKeyAction("top", "g", "go to latest", "g", "home"),
+	newKeyAction("bottom", "G", "go to oldest", "G", "end"),
+	newKeyAction("jump", "enter", "jump to item", "enter"),
+	newKeyAction("open", "x", "open in browser", "x"),
+	newKeyAction("back", "backspace", "back", "backspace", "ctrl+h"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
+func (k timelineKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{
+		k.Down,
+		k.Up,
+		k.Jump,
+		k.Open,
+		k.Back,
+		k.Quit,
+	}
+}
+
+func (k timelineKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{
+			k.Down,
+			k.Up,
+		},
+		{
+			k.NextMonth,
+			k.PrevMonth,
+		},
+		{
+			k.Top,
+			k.Bottom,
+		},
+		{
+			k.Jump,
+			k.Open,
+		},
+		{
+			k.Back,
+			k.Quit,
+		},
+	}
+}
+
+// timelineLine is a line of the timeline, which is either a month header or an event.
+type timelineLine struct {
+	month *timeline.Month
+	event *timeline.Event
+}
+
+type timelineModel struct {
+	client *gh.GitHubClient
+
+	keys    timelineKeyMap
+	help    help.Model
+	spinner *spinner.Model
+
+	lines  []timelineLine
+	events []int // line indexes of the events
+	// the cursor is the index of events, and the offset is the first line shown
+	listCursor
+
+	errorMsg      *timelineErrorMsg
+	loading       bool
+	selectedUser  string
+	width, height int
+}
+
+func newTimelineModel(client *gh.GitHubClient, s *spinner.Model) timelineModel {
+	keys := timelineKeyMap{
+		Down:      timelineKeys.binding("down"),
+		Up:        timelineKeys.binding("up"),
+		NextMonth: timelineKeys.binding("next-month"),
+		PrevMonth: timelineKeys.binding("prev-month"),
+		Top:       timelineKeys.binding("top"),
+		Bottom:    timelineKeys.binding("bottom"),
+		Jump:      timelineKeys.binding("jump"),
+		Open:      timelineKeys.binding("open"),
+		Back:      timelineKeys.binding("back"),
+		Quit:      timelineKeys.binding("quit"),
+	}
+	return timelineModel{
+		client:  client,
+		keys:    keys,
+		help:    help.New(),
+		spinner: s,
+	}
+}
+
+func init() {
+	registerPage(func(ctx *pageContext, _ selectTimelinePageMsg) page {
+		m := newTimelineModel(ctx.client, ctx.spinner)
+		m.SetUser(ctx.user)
+		return newRoutedPage(m)
+	})
+}
+
+func (m *timelineModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.help.Width = width
+	m.scroll()
+}
+
+func (m *timelineModel) SetUser(id string) {
+	m.selectedUser = id
+}
+
+func (m *timelineModel) updateEvents(events []*timeline.Event) {
+	m.lines = make([]timelineLine, 0)
+	m.events = make([]int, 0)
+	for _, month := range timeline.GroupByMonth(events) {
+		m.lines = append(m.lines, timelineLine{month: month})
+		for _, e := range month.Events {
+			m.events = append(m.events, len(m.lines))
+			m.lines = append(m.lines, timelineLine{event: e})
+		}
+	}
+	m.reset()
+}
+
+func (m *timelineModel) selectedEvent() *timeline.Event {
+	if m.cursor < len(m.events) {
+		return m.lines[m.events[m.cursor]].event
+	}
+	return nil
+}
+
+func (m *timelineModel) visibleLines() int {
+	t, _, b, _ := timelineStyle.GetPadding()
+	// title (2) and help (2)
+	return max(m.height-4-t-b, 1)
+}
+
+// linesTop returns the line of the first line of the timeline, whose padding follows the title.
+func (m timelineModel) linesTop() int {
+	t, _, _, _ := timelineStyle.GetPadding()
+	return 1 + t
+}
+
+// scroll keeps the selected event, and the header of its month if possible, inside the screen.
+func (m *timelineModel) scroll() {
+	if len(m.events) == 0 {
+		return
+	}
+	line := m.events[m.cursor]
+	m.listCursor.scroll(max(line-1, 0), line, m.visibleLines(), len(m.lines))
+}
+
+func (m *timelineModel) move(delta int) {
+	m.listCursor.move(delta, len(m.events), false)
+	m.scroll()
+}
+
+// moveMonth selects the first event of the next (older) or previous (newer) month.
+func (m *timelineModel) moveMonth(older bool) {
+	if len(m.events) == 0 {
+		return
+	}
+	line := m.events[m.cursor]
+	if older {
+		for i := line + 1; i < len(m.lines); i++ {
+			if m.lines[i].month != nil {
+				m.selectLine(i + 1)
+				return
+			}
+		}
+		return
+	}
+	// the header of the current month, then the header of the previous month
+	headers := 0
+	for i := line; i >= 0; i-- {
+		if m.lines[i].month != nil {
+			headers++
+			if headers == 2 {
+				m.selectLine(i + 1)
+				return
+			}
+		}
+	}
+}
+
+func (m *timelineModel) selectLine(line int) {
+	for i, l := range m.events {
+		if l == line {
+			m.cursor = i
+			m.scroll()
+			return
+		}
+	}
+}
+
+func (m timelineModel) Init() tea.Cmd {
+	return nil
+}
+
+type timelineSuccessMsg struct {
+	events []*timeline.Event
+}
+
+var _ tea.Msg = (*timelineSuccessMsg)(nil)
+
+type timelineErrorMsg struct {
+	e       error
+	summary string
+}
+
+var _ tea.Msg = (*timelineErrorMsg)(nil)
+
+func (m timelineModel) loadTimeline(id string) tea.Cmd {
+	return func() tea.Msg {
+		prs, err := m.client.QueryUserPullRequests(id)
+		if err != nil {
+			return timelineErrorMsg{err, "failed to fetch pull requests"}
+		}
+		repos, err := m.client.QueryUserRepositories(id)
+		if err != nil {
+			return timelineErrorMsg{err, "failed to fetch repositories"}
+		}
+		events := timeline.Merge(timeline.PullRequestEvents(prs), timeline.RepositoryEvents(repos))
+		return timelineSuccessMsg{events}
+	}
+}
+
+func (m timelineModel) openInBrowser(e *timeline.Event) tea.Cmd {
+	return func() tea.Msg {
+		if err := openBrowser(e.Url); err != nil {
+			return profileErrorMsg{err, "failed to open browser"}
+		}
+		return nil
+	}
+}
+
+// jump opens the page of the pull request or the repository of the event.
+func (m timelineModel) jump(e *timeline.Event) tea.Cmd {
+	if e.Kind.IsPullRequest() {
+		return selectPullRequestsPageFocused(m.selectedUser, &pullRequestsFocus{e.Owner, e.Repository, e.Number})
+	}
+	return selectRepositoriesPageFocused(m.selectedUser, e.Repository)
+}
+
+func (m timelineModel) Update(msg tea.Msg) (timelineModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch {
+		case key.Matches(msg, m.keys.Down):
+			m.move(1)
+		case key.Matches(msg, m.keys.Up):
+			m.move(-1)
+		case key.Matches(msg, m.keys.NextMonth):
+			m.moveMonth(true)
+		case key.Matches(msg, m.keys.PrevMonth):
+			m.moveMonth(false)
+		case key.Matches(msg, m.keys.Top):
+			m.move(-len(m.events))
+		case key.Matches(msg, m.keys.Bottom):
+			m.move(len(m.events))
+		case key.Matches(msg, m.keys.Jump):
+			if e := m.selectedEvent(); e != nil {
+				return m, m.jump(e)
+			}
+		case key.Matches(msg, m.keys.Open):
+			if e := m.selectedEvent(); e != nil {
+				return m, m.openInBrowser(e)
+			}
+		case key.Matches(msg, m.keys.Back):
+			return m, goBack
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+		}
+	case tea.MouseMsg:
+		if m.loading || m.errorMsg != nil || len(m.events) == 0 {
+			return m, nil
+		}
+		if d := wheelDelta(msg); d != 0 {
+			m.move(d)
+			return m, nil
+		}
+		if row := clickedRow(msg, m.linesTop()); 0 <= row && row < m.visibleLines() {
+			line := m.offset + row
+			if line == m.events[m.cursor] {
+				return m.Update(keyPress(m.keys.Jump))
+			}
+			// the headers of the months are not selected
+			m.selectLine(line)
+		}
+		return m, nil
+	case selectTimelinePageMsg:
+		m.loading = true
+		return m, m.loadTimeline(msg.id)
+	case timelineSuccessMsg:
+		m.errorMsg = nil
+		m.loading = false
+		m.updateEvents(msg.events)
+		return m, nil
+	case timelineErrorMsg:
+		m.errorMsg = &msg
+		m.loading = false
+		return m, nil
+	}
+	return m, nil
+}
+
+func (m timelineModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
+func (m timelineModel) yankTargets() (*yankTarget, []*yankTarget) {
+	if m.loading || m.errorMsg != nil {
+		return nil, nil
+	}
+	var selected *yankTarget
+	visible := make([]*yankTarget, len(m.events))
+	for i, l := range m.events {
+		visible[i] = m.eventYankTarget(m.lines[l].event)
+		if i == m.cursor {
+			selected = visible[i]
+		}
+	}
+	return selected, visible
+}
+
+func (m timelineModel) eventYankTarget(e *timeline.Event) *yankTarget {
+	if e.Kind.IsPullRequest() {
+		return pullRequestYankTarget(e.Owner+"/"+e.Repository, e.Number, e.Title, e.Url)
+	}
+	// the repositories are of the user
+	return repositoryYankTarget(m.selectedUser+"/"+e.Repository, e.Url)
+}
+
+func (m timelineModel) View() string {
+	if m.loading {
+		return loadingView(m.spinner, m.breadcrumb())
+	}
+	if m.errorMsg != nil {
+		return m.errorView()
+	}
+	return m.timelineView()
+}
+
+func (m timelineModel) timelineView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	tl := timelineStyle.Render(m.linesView())
+	ret += tl
+	height -= cn(tl)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", max(height, 0))
+	ret += help
+
+	return ret
+}
+
+func (m timelineModel) linesView() string {
+	if len(m.lines) == 0 {
+		return "No activities"
+	}
+	_, r, _, l := timelineStyle.GetPadding()
+	width := m.width - r - l
+
+	selected := m.selectedEvent()
+	end := min(m.offset+m.visibleLines(), len(m.lines))
+	views := make([]string, 0, end-m.offset)
+	for _, line := range m.lines[m.offset:end] {
+		if line.month != nil {
+			views = append(views, timelineMonthStyle.Render(line.month.Month.Format("January 2006")))
+			continue
+		}
+		views = append(views, timelineEventView(line.event, line.event == selected, width))
+	}
+	return strings.Join(views, "\n")
+}
+
+func timelineEventView(e *timeline.Event, selected bool, width int) string {
+	var icon, action, target string
+	switch e.Kind {
+	case timeline.PullRequestOpened:
+		icon, action = statusOpenStyle.Copy().UnsetUnderline().Render("○"), "Opened"
+	case timeline.PullRequestMerged:
+		icon, action = statusMergedStyle.Copy().UnsetUnderline().Render("●"), "Merged"
+	case timeline.PullRequestClosed:
+		icon, action = statusClosedStyle.Copy().UnsetUnderline().Render("✕"), "Closed"
+	case timeline.RepositoryCreated:
+		icon, action = timelineRepositoryIconStyle.Render("✚"), "Created"
+	case timeline.RepositoryPushed:
+		icon, action = timelineRepositoryIconStyle.Render("↑"), "Pushed"
+	}
+	if e.Kind.IsPullRequest() {
+		target = fmt.Sprintf("%s/%s #%d", e.Owner, e.Repository, e.Number)
+	} else {
+		target = e.Repository
+	}
+
+	cursor := "  "
+	if selected {
+		cursor = timelineSelectedStyle.Render("> ")
+	}
+	date := timelineDateStyle.Render(e.Time.Local().Format("01-02"))
+	text := fmt.Sprintf("%-7s %s", action, target)
+	if e.Title != "" {
+		text += "  " + e.Title
+	}
+	// cursor (2), icon (1), date (5) and spaces (2)
+	text = truncate.StringWithTail(text, uint(max(width-10, 0)), "…")
+	if selected {
+		text = timelineSelectedStyle.Render(text)
+	}
+	return cursor + icon + " " + date + " " + text
+}
+
+func (m timelineModel) errorView() string {
+	if m.height <= 0 {
+		return ""
+	}
+
+	ret := ""
+	height := m.height - 1
+
+	title := titleView(m.breadcrumb())
+	ret += title
+	height -= cn(title)
+
+	errorText := timelineErrorStyle.Render("ERROR: " + m.errorMsg.summary)
+	ret += errorText
+	height -= cn(errorText)
+
+	help := helpStyle.Render(m.help.View(m.keys))
+	height -= cn(help)
+
+	ret += strings.Repeat("\n", height)
+	ret += help
+
+	return ret
+}
+
+func (m timelineModel) breadcrumb() []string {
+	return []string{m.selectedUser, "Timeline"}
+}
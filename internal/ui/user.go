@@ -1,7 +1,10 @@
 package ui
 
 import (
+	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -10,6 +13,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/lusingander/ghcv-cli/internal/gh"
+	"github.com/lusingander/ghcv-cli/internal/theme"
 )
 
 var (
@@ -19,12 +23,47 @@ var (
 	inputUserStyle = lipgloss.NewStyle().
 			Padding(1, 0, 0, 2)
 
+	inputOtherUserStyle = lipgloss.NewStyle().
+				Padding(1, 0, 0, 2)
+
 	inputSpinnerStyle = lipgloss.NewStyle().
 				Padding(2, 0, 0, 2)
 
 	inputErrorStyle = lipgloss.NewStyle().
 			Padding(2, 0, 0, 2).
-			Foreground(lipgloss.Color("161"))
+			Foreground(theme.Error.Color())
+
+	inputHistoryStyle = lipgloss.NewStyle().
+				Padding(2, 0, 0, 2)
+
+	inputHistorySelectedStyle = lipgloss.NewStyle().
+					Foreground(theme.Selected.Color())
+
+	inputHistoryFavoriteStyle = lipgloss.NewStyle().
+					Foreground(theme.Favorite.Color())
+
+	inputHistoryTimeStyle = lipgloss.NewStyle().
+				Foreground(theme.Muted.Color())
+
+	inputSuggestionNoteStyle = lipgloss.NewStyle().
+					Foreground(theme.Dimmed.Color())
+
+	inputAvatarStyle = lipgloss.NewStyle().
+				Foreground(theme.AvatarText.Color()).
+				Bold(true)
+)
+
+const (
+	// the number of history entries shown under the input
+	userSelectHistoryRows = 8
+	// the number of search results shown under the input
+	userSelectSuggestionRows = 5
+	// searching starts after the input stops changing for this duration
+	userSearchDebounce = 400 * time.Millisecond
+	// shorter inputs match too many users to be useful
+	userSearchMinLength = 2
+	// the number of users ranked for "did you mean"
+	userSearchDidYouMeanCandidates = 20
 )
 
 type userSelectModel struct {
@@ -35,19 +74,63 @@ type userSelectModel struct {
 	help    help.Model
 	spinner *spinner.Model
 
+	// in compare mode, the second user is entered in other
+	compare bool
+	other   textinput.Model
+
+	teams      []*team
+	teamDialog *selectDialog
+
+	history []*userHistoryEntry
+	// the history is not stored if it could not be loaded, not to overwrite the file
+	historyErr error
+
+	// suggestions are the search results of suggestionsQuery
+	suggestions      []*gh.UserSuggestion
+	suggestionsQuery string
+	searchSeq        int
+	// set when the user was not found, then only the suggestions are listed
+	didYouMean bool
+
+	cursor int // index of items, -1 if not selected
+
 	errorMsg      *userSelectErrorMsg
 	loading       bool
 	width, height int
 }
 
 type userSelectKeyMap struct {
-	Enter key.Binding
-	Quit  key.Binding
+	Enter    key.Binding
+	Complete key.Binding
+	Next     key.Binding
+	Prev     key.Binding
+	Favorite key.Binding
+	Compare  key.Binding
+	Switch   key.Binding
+	Teams    key.Binding
+	Quit     key.Binding
 }
 
+var userKeys = registerKeyScope("user",
+	newKeyAction("confirm", "enter", "confirm", "enter"),
+	newKeyAction("complete", "tab", "complete", "tab"),
+	newKeyAction("next", "↓", "next history", "down", "ctrl+n"),
+	newKeyAction("prev", "↑", "prev history", "up"),
+	newKeyAction("favorite", "ctrl+s", "star", "ctrl+s"),
+	newKeyAction("compare", "ctrl+t", "compare two users", "ctrl+t"),
+	newKeyAction("switch", "shift+tab", "switch user", "shift+tab"),
+	newKeyAction("teams", "ctrl+g", "teams", "ctrl+g"),
+	newKeyAction("quit", "ctrl+c", "quit", "ctrl+c", "esc"),
+)
+
 func (k userSelectKeyMap) ShortHelp() []key.Binding {
 	return []key.Binding{
 		k.Enter,
+		k.Complete,
+		k.Favorite,
+		k.Compare,
+		k.Switch,
+		k.Teams,
 		k.Quit,
 	}
 }
@@ -56,6 +139,19 @@ func (k userSelectKeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{
 			k.Enter,
+			k.Complete,
+		},
+		{
+			k.Next,
+			k.Prev,
+			k.Favorite,
+		},
+		{
+			k.Compare,
+			k.Switch,
+		},
+		{
+			k.Teams,
 		},
 		{
 			k.Quit,
@@ -65,38 +161,251 @@ func (k userSelectKeyMap) FullHelp() [][]key.Binding {
 
 func newUserSelectModel(client *gh.GitHubClient, s *spinner.Model) userSelectModel {
 	userSelectKeys := userSelectKeyMap{
-		Enter: key.NewBinding(
-			key.WithKeys("enter"),
-			key.WithHelp("enter", "confirm"),
-		),
-		Quit: key.NewBinding(
-			key.WithKeys("ctrl+c", "esc"),
-			key.WithHelp("ctrl+c", "quit"),
-		),
+		Enter:    userKeys.binding("confirm"),
+		Complete: userKeys.binding("complete"),
+		Next:     userKeys.binding("next"),
+		Prev:     userKeys.binding("prev"),
+		Favorite: userKeys.binding("favorite"),
+		Compare:  userKeys.binding("compare"),
+		Switch:   userKeys.binding("switch"),
+		Teams:    userKeys.binding("teams"),
+		Quit:     userKeys.binding("quit"),
 	}
+	// enabled while comparing two users
+	userSelectKeys.Switch.SetEnabled(false)
 
 	inputModel := textinput.New()
 	inputModel.Placeholder = "GitHub ID"
 	inputModel.Focus()
 
-	return userSelectModel{
-		client:  client,
-		keys:    userSelectKeys,
-		input:   inputModel,
-		help:    help.New(),
-		spinner: s,
+	otherModel := textinput.New()
+	otherModel.Placeholder = "GitHub ID to compare"
+
+	m := userSelectModel{
+		client:     client,
+		keys:       userSelectKeys,
+		input:      inputModel,
+		other:      otherModel,
+		teamDialog: newSelectDialog("Teams", userSelectKeys.Teams.Keys(), false),
+		cursor:     -1,
+		help:       help.New(),
+		spinner:    s,
 	}
+	m.loadHistory()
+	return m
 }
 
 func (m *userSelectModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
 	m.help.Width = width
+	m.teamDialog.SetSize(width, height)
 }
 
 func (m *userSelectModel) Reset() {
 	m.input.Reset()
+	m.other.Reset()
+	m.other.Blur()
 	m.input.Focus()
+	m.loadHistory()
+	m.clearSuggestions()
+	m.cursor = -1
+}
+
+func (m *userSelectModel) focusedInput() *textinput.Model {
+	if m.other.Focused() {
+		return &m.other
+	}
+	return &m.input
+}
+
+func (m *userSelectModel) focusedValue() string {
+	return strings.TrimSpace(m.focusedInput().Value())
+}
+
+// userSelectItem is a row listed under the input, either from the history or the search results.
+type userSelectItem struct {
+	login      string
+	history    *userHistoryEntry
+	suggestion *gh.UserSuggestion
+}
+
+// items returns the history entries matching the focused input, followed by the search results of it.
+func (m *userSelectModel) items() []*userSelectItem {
+	items := make([]*userSelectItem, 0)
+	if !m.didYouMean {
+		matches := matchUserHistory(m.history, m.focusedInput().Value())
+		for _, e := range matches[:min(len(matches), userSelectHistoryRows)] {
+			items = append(items, &userSelectItem{login: e.Login, history: e})
+		}
+	}
+	if !m.didYouMean && !strings.EqualFold(m.suggestionsQuery, m.focusedValue()) {
+		return items
+	}
+	n := 0
+	for _, s := range m.suggestions {
+		if n >= userSelectSuggestionRows {
+			break
+		}
+		if m.listed(items, s.Login) {
+			continue
+		}
+		items = append(items, &userSelectItem{login: s.Login, suggestion: s})
+		n++
+	}
+	return items
+}
+
+func (m *userSelectModel) listed(items []*userSelectItem, login string) bool {
+	for _, item := range items {
+		if strings.EqualFold(item.login, login) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *userSelectModel) selectedItem() *userSelectItem {
+	items := m.items()
+	if 0 <= m.cursor && m.cursor < len(items) {
+		return items[m.cursor]
+	}
+	return nil
+}
+
+func (m *userSelectModel) moveCursor(delta int) {
+	n := len(m.items())
+	if n == 0 {
+		m.cursor = -1
+		return
+	}
+	// -1 (the input) is included in the cycle
+	m.cursor = ((m.cursor+1+delta)%(n+1)+(n+1))%(n+1) - 1
+}
+
+// complete fills the focused input with the selected item, or the first item.
+func (m *userSelectModel) complete() {
+	item := m.selectedItem()
+	if item == nil {
+		if items := m.items(); len(items) > 0 {
+			item = items[0]
+		}
+	}
+	if item == nil {
+		return
+	}
+	input := m.focusedInput()
+	input.SetValue(item.login)
+	input.CursorEnd()
+	m.cursor = -1
+	m.didYouMean = false
+}
+
+// toggleFavorite stars the selected item, or the login in the focused input, if it is in the history.
+func (m *userSelectModel) toggleFavorite() {
+	login := m.focusedValue()
+	if item := m.selectedItem(); item != nil {
+		login = item.login
+	}
+	e := findUserHistory(m.history, login)
+	if e == nil {
+		return
+	}
+	e.Favorite = !e.Favorite
+	sortUserHistory(m.history)
+	m.cursor = -1
+	m.storeHistory()
+}
+
+func (m *userSelectModel) addHistory(logins ...string) {
+	for _, login := range logins {
+		m.history = addUserHistory(m.history, login, time.Now())
+	}
+	m.storeHistory()
+}
+
+// loadHistory loads the history, and shows the error if it cannot be loaded.
+// The history is a convenience, so the page works without it.
+func (m *userSelectModel) loadHistory() {
+	m.history, m.historyErr = loadUserHistory()
+	if m.historyErr != nil {
+		m.errorMsg = &userSelectErrorMsg{m.historyErr, "failed to load history: " + m.historyErr.Error()}
+	}
+}
+
+func (m *userSelectModel) storeHistory() {
+	if m.historyErr != nil {
+		m.errorMsg = &userSelectErrorMsg{m.historyErr, "history is not saved since it could not be loaded: " + m.historyErr.Error()}
+		return
+	}
+	if err := storeUserHistory(m.history); err != nil {
+		m.errorMsg = &userSelectErrorMsg{err, "failed to save history: " + err.Error()}
+	}
+}
+
+func (m *userSelectModel) clearSuggestions() {
+	m.suggestions = nil
+	m.suggestionsQuery = ""
+	m.didYouMean = false
+}
+
+// debounceSearch searches the input after a while, unless it is changed in the meantime.
+func (m *userSelectModel) debounceSearch() tea.Cmd {
+	m.searchSeq++
+	seq, query := m.searchSeq, m.focusedValue()
+	if len([]rune(query)) < userSearchMinLength {
+		return nil
+	}
+	return tea.Tick(userSearchDebounce, func(time.Time) tea.Msg {
+		return userSearchMsg{seq, query}
+	})
+}
+
+func (m *userSelectModel) toggleCompare() {
+	m.compare = !m.compare
+	m.keys.Switch.SetEnabled(m.compare)
+	if !m.compare {
+		m.other.Blur()
+		m.input.Focus()
+	}
+}
+
+func (m *userSelectModel) switchInput() {
+	if m.input.Focused() {
+		m.input.Blur()
+		m.other.Focus()
+	} else {
+		m.other.Blur()
+		m.input.Focus()
+	}
+}
+
+func (m *userSelectModel) openTeamDialog() {
+	teams, err := loadTeams()
+	if err != nil {
+		m.errorMsg = &userSelectErrorMsg{err, err.Error()}
+		return
+	}
+	if len(teams) == 0 {
+		m.errorMsg = &userSelectErrorMsg{nil, "no teams defined in " + teamsFileName}
+		return
+	}
+	m.teams = teams
+	items := make([]selectDialogItem, len(teams))
+	for i, t := range teams {
+		note := t.OrgTeam
+		if len(t.Members) > 0 {
+			note = strings.TrimSpace(fmt.Sprintf("%d members %s", len(t.Members), note))
+		}
+		items[i] = selectDialogItem{name: t.Name, note: note}
+	}
+	m.teamDialog.setItems(items)
+	m.teamDialog.open(0)
+}
+
+func (m *userSelectModel) blur() {
+	m.input.Blur()
+	m.other.Blur()
 }
 
 func (m userSelectModel) Init() tea.Cmd {
@@ -109,6 +418,13 @@ type userSelectSuccessMsg struct {
 
 var _ tea.Msg = (*userSelectSuccessMsg)(nil)
 
+type compareUsersSuccessMsg struct {
+	id    string
+	other string
+}
+
+var _ tea.Msg = (*compareUsersSuccessMsg)(nil)
+
 type userSelectErrorMsg struct {
 	e       error
 	summary string
@@ -116,6 +432,52 @@ type userSelectErrorMsg struct {
 
 var _ tea.Msg = (*userSelectErrorMsg)(nil)
 
+type userNotFoundMsg struct {
+	id          string
+	suggestions []*gh.UserSuggestion
+}
+
+var _ tea.Msg = (*userNotFoundMsg)(nil)
+
+type userSearchMsg struct {
+	seq   int
+	query string
+}
+
+var _ tea.Msg = (*userSearchMsg)(nil)
+
+type userSearchSuccessMsg struct {
+	query string
+	users []*gh.UserSuggestion
+}
+
+var _ tea.Msg = (*userSearchSuccessMsg)(nil)
+
+func (m userSelectModel) searchUsers(query string) tea.Cmd {
+	return func() tea.Msg {
+		users, err := m.client.SearchUsers(query, userSelectSuggestionRows+userSelectHistoryRows)
+		if err != nil {
+			// suggestions are optional, the input can be confirmed as it is
+			return nil
+		}
+		return userSearchSuccessMsg{query, users}
+	}
+}
+
+// suggestUsers returns the users whose logins are close to id.
+// If id gives no results, the first half of it is searched, since a typo often breaks the search.
+func (m userSelectModel) suggestUsers(id string) []*gh.UserSuggestion {
+	users, _ := m.client.SearchUsers(id, userSearchDidYouMeanCandidates)
+	if r := []rune(id); len(users) == 0 && len(r) > 3 {
+		users, _ = m.client.SearchUsers(string(r[:max(len(r)/2, 3)]), userSearchDidYouMeanCandidates)
+	}
+	lower := strings.ToLower(id)
+	sort.SliceStable(users, func(i, j int) bool {
+		return editDistance(strings.ToLower(users[i].Login), lower) < editDistance(strings.ToLower(users[j].Login), lower)
+	})
+	return users[:min(len(users), userSelectSuggestionRows)]
+}
+
 func (m userSelectModel) checkUser() tea.Cmd {
 	id := strings.TrimSpace(m.input.Value())
 	if id == "" {
@@ -125,28 +487,107 @@ func (m userSelectModel) checkUser() tea.Cmd {
 		if m.client.ExistUser(id) {
 			return userSelectSuccessMsg{id}
 		}
-		return userSelectErrorMsg{nil, "user not found"}
+		return userNotFoundMsg{id, m.suggestUsers(id)}
+	}
+}
+
+func (m userSelectModel) checkUsers() tea.Cmd {
+	id := strings.TrimSpace(m.input.Value())
+	other := strings.TrimSpace(m.other.Value())
+	if id == "" || other == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		if strings.EqualFold(id, other) {
+			return userSelectErrorMsg{nil, "enter two different users"}
+		}
+		for _, u := range []string{id, other} {
+			if !m.client.ExistUser(u) {
+				return userNotFoundMsg{u, m.suggestUsers(u)}
+			}
+		}
+		return compareUsersSuccessMsg{id, other}
 	}
 }
 
 func (m userSelectModel) Update(msg tea.Msg) (userSelectModel, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0)
+	if m.teamDialog.opened {
+		switch m.teamDialog.update(msg) {
+		case selectDialogEntered:
+			m.teamDialog.opened = false
+			return m, selectTeamPage(m.teams[m.teamDialog.selected()])
+		case selectDialogIgnored:
+			if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.keys.Quit) {
+				return m, tea.Quit
+			}
+		}
+		return m, nil
+	}
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if !m.itemsVisible() {
+			return m, nil
+		}
+		if d := wheelDelta(msg); d != 0 {
+			m.moveCursor(d)
+			return m, nil
+		}
+		if row := clickedRow(msg, m.itemsTop()); 0 <= row && row < len(m.items()) {
+			if row == m.cursor {
+				return m.Update(keyPress(m.keys.Enter))
+			}
+			m.cursor = row
+		}
+		return m, nil
 	case tea.KeyMsg:
 		switch {
+		case key.Matches(msg, m.keys.Teams):
+			m.errorMsg = nil
+			m.openTeamDialog()
+			return m, nil
 		case key.Matches(msg, m.keys.Enter):
+			if m.selectedItem() != nil {
+				m.complete()
+			}
 			cmd := m.checkUser()
+			if m.compare {
+				cmd = m.checkUsers()
+			}
 			if cmd == nil {
 				return m, nil
 			}
-			m.input.Blur()
+			m.blur()
 			m.errorMsg = nil
 			m.loading = true
 			return m, cmd
+		case key.Matches(msg, m.keys.Complete):
+			m.complete()
+			return m, nil
+		case key.Matches(msg, m.keys.Next):
+			m.moveCursor(1)
+			return m, nil
+		case key.Matches(msg, m.keys.Prev):
+			m.moveCursor(-1)
+			return m, nil
+		case key.Matches(msg, m.keys.Favorite):
+			m.errorMsg = nil
+			m.toggleFavorite()
+			return m, nil
+		case key.Matches(msg, m.keys.Compare):
+			m.errorMsg = nil
+			m.cursor = -1
+			m.toggleCompare()
+			return m, nil
+		case key.Matches(msg, m.keys.Switch):
+			m.cursor = -1
+			m.switchInput()
+			return m, nil
 		case key.Matches(msg, m.keys.Quit):
 			return m, tea.Quit
 		default:
 			m.errorMsg = nil
+			m.cursor = -1
 		}
 	case goBackUserSelectPageMsg:
 		m.Reset()
@@ -154,21 +595,67 @@ func (m userSelectModel) Update(msg tea.Msg) (userSelectModel, tea.Cmd) {
 	case userSelectSuccessMsg:
 		m.errorMsg = nil
 		m.loading = false
+		m.addHistory(msg.id)
 		return m, userSelected(msg.id)
+	case compareUsersSuccessMsg:
+		m.errorMsg = nil
+		m.loading = false
+		m.addHistory(msg.id, msg.other)
+		return m, selectComparePage(msg.id, msg.other)
 	case userSelectErrorMsg:
 		m.errorMsg = &msg
 		m.loading = false
 		m.input.Focus()
 		return m, nil
+	case userNotFoundMsg:
+		m.errorMsg = &userSelectErrorMsg{nil, "user not found: " + msg.id}
+		m.loading = false
+		if m.compare && strings.EqualFold(strings.TrimSpace(m.other.Value()), msg.id) {
+			m.other.Focus()
+		} else {
+			m.input.Focus()
+		}
+		m.suggestions = msg.suggestions
+		m.suggestionsQuery = msg.id
+		m.didYouMean = len(msg.suggestions) > 0
+		m.cursor = -1
+		return m, nil
+	case userSearchMsg:
+		if msg.seq != m.searchSeq {
+			return m, nil
+		}
+		return m, m.searchUsers(msg.query)
+	case userSearchSuccessMsg:
+		// the result of an old input is discarded
+		if strings.EqualFold(msg.query, m.focusedValue()) {
+			m.suggestions = msg.users
+			m.suggestionsQuery = msg.query
+		}
+		return m, nil
 	}
 
+	value := m.focusedValue()
+
 	input, iCmd := m.input.Update(msg)
 	m.input = input
 	cmds = append(cmds, iCmd)
 
+	other, oCmd := m.other.Update(msg)
+	m.other = other
+	cmds = append(cmds, oCmd)
+
+	if m.focusedValue() != value {
+		m.didYouMean = false
+		cmds = append(cmds, m.debounceSearch())
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
+func (m userSelectModel) keyBindings() [][]key.Binding {
+	return m.keys.FullHelp()
+}
+
 func (m userSelectModel) View() string {
 	if m.height <= 0 {
 		return ""
@@ -177,17 +664,9 @@ func (m userSelectModel) View() string {
 	ret := ""
 	height := m.height - 1
 
-	title := titleView(nil)
-	ret += title
-	height -= cn(title)
-
-	label := inputLabelStyle.Render("Enter GitHub User ID")
-	ret += label
-	height -= cn(label)
-
-	input := inputUserStyle.Render(m.input.View())
-	ret += input
-	height -= cn(input)
+	inputs := m.inputsView()
+	ret += inputs
+	height -= cn(inputs)
 
 	if m.loading {
 		sp := inputSpinnerStyle.Render(m.spinner.View() + " Loading...")
@@ -201,11 +680,126 @@ func (m userSelectModel) View() string {
 		height -= cn(errorText)
 	}
 
+	if m.itemsVisible() {
+		if iv := m.itemsView(); iv != "" {
+			if m.didYouMean {
+				iv = "Did you mean:\n\n" + iv
+			}
+			items := inputHistoryStyle.Render(iv)
+			ret += items
+			height -= cn(items)
+		}
+	}
+
 	help := helpStyle.Render(m.help.View(m.keys))
 	height -= cn(help)
 
-	ret += strings.Repeat("\n", height)
+	ret += strings.Repeat("\n", max(height, 0))
 	ret += help
 
+	if m.teamDialog.opened {
+		return m.teamDialog.view(ret)
+	}
+	return ret
+}
+
+// inputsView renders the title and the inputs above the items.
+func (m userSelectModel) inputsView() string {
+	ret := titleView(nil)
+
+	labelText := "Enter GitHub User ID"
+	if m.compare {
+		labelText = "Enter GitHub User IDs to compare"
+	}
+	ret += inputLabelStyle.Render(labelText)
+	ret += inputUserStyle.Render(m.input.View())
+	if m.compare {
+		ret += inputOtherUserStyle.Render(m.other.View())
+	}
 	return ret
 }
+
+// itemsVisible reports whether the items are shown below the inputs.
+func (m userSelectModel) itemsVisible() bool {
+	return !m.loading && (m.errorMsg == nil || m.didYouMean)
+}
+
+// itemsTop returns the line of the first item, below the inputs.
+func (m userSelectModel) itemsTop() int {
+	t, _, _, _ := inputHistoryStyle.GetPadding()
+	top := cn(m.inputsView()) + t
+	if m.errorMsg != nil {
+		top += cn(inputErrorStyle.Render("ERROR: " + m.errorMsg.summary))
+	}
+	if m.didYouMean {
+		// "Did you mean:" and a blank line
+		top += 2
+	}
+	return top
+}
+
+func (m userSelectModel) itemsView() string {
+	items := m.items()
+	if len(items) == 0 {
+		return ""
+	}
+	loginWidth := 0
+	for _, item := range items {
+		loginWidth = max(loginWidth, lipgloss.Width(item.login))
+	}
+	lines := make([]string, len(items))
+	for i, item := range items {
+		mark, note := "  ", ""
+		if item.history != nil {
+			if item.history.Favorite {
+				mark = inputHistoryFavoriteStyle.Render("★") + " "
+			}
+			note = inputHistoryTimeStyle.Render(formatDuration(item.history.LastUsed))
+		} else {
+			mark = avatarView(item.suggestion)
+			note = inputSuggestionNoteStyle.Render(suggestionNote(item.suggestion))
+		}
+		login := fmt.Sprintf("%-*s", loginWidth, item.login)
+		cursor := "  "
+		if i == m.cursor {
+			cursor = inputHistorySelectedStyle.Render("> ")
+			login = inputHistorySelectedStyle.Render(login)
+		}
+		lines[i] = cursor + mark + " " + login + "  " + note
+	}
+	return strings.Join(lines, "\n")
+}
+
+// avatarView renders the initials of the user in the color derived from the login.
+func avatarView(u *gh.UserSuggestion) string {
+	words := strings.Fields(u.Name)
+	if len(words) == 0 {
+		words = []string{u.Login}
+	}
+	initials := make([]rune, 0, 2)
+	for _, w := range words[:min(len(words), 2)] {
+		initials = append(initials, []rune(w)[0])
+	}
+	if len(initials) == 1 {
+		if r := []rune(words[0]); len(r) > 1 {
+			initials = append(initials, r[1])
+		}
+	}
+	s := fmt.Sprintf("%-2s", strings.ToUpper(string(initials)))
+	h := 0
+	for _, r := range u.Login {
+		h = h*31 + int(r)
+	}
+	return inputAvatarStyle.Background(theme.Accent(h)).Render(s)
+}
+
+func suggestionNote(u *gh.UserSuggestion) string {
+	followers := fmt.Sprintf("%d followers", u.Followers)
+	if u.Followers == 1 {
+		followers = "1 follower"
+	}
+	if u.Name == "" {
+		return followers
+	}
+	return u.Name + " · " + followers
+}
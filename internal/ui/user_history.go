@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+)
+
+const (
+	userHistoryFileName = "history.json"
+	// favorites are kept regardless of this limit
+	userHistoryMaxEntries = 50
+)
+
+type userHistoryEntry struct {
+	Login    string    `json:"login"`
+	LastUsed time.Time `json:"last_used"`
+	Favorite bool      `json:"favorite,omitempty"`
+}
+
+type userHistoryFile struct {
+	Users []*userHistoryEntry `json:"users"`
+}
+
+func loadUserHistory() ([]*userHistoryEntry, error) {
+	var f userHistoryFile
+	if err := ghcv.LoadConfigJSON(userHistoryFileName, &f); err != nil {
+		return nil, err
+	}
+	sortUserHistory(f.Users)
+	return f.Users, nil
+}
+
+func storeUserHistory(entries []*userHistoryEntry) error {
+	return ghcv.StoreConfigJSON(userHistoryFileName, userHistoryFile{Users: entries})
+}
+
+// sortUserHistory puts the favorites first, then the most recently used first.
+func sortUserHistory(entries []*userHistoryEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Favorite != entries[j].Favorite {
+			return entries[i].Favorite
+		}
+		return entries[i].LastUsed.After(entries[j].LastUsed)
+	})
+}
+
+func findUserHistory(entries []*userHistoryEntry, login string) *userHistoryEntry {
+	for _, e := range entries {
+		if strings.EqualFold(e.Login, login) {
+			return e
+		}
+	}
+	return nil
+}
+
+// addUserHistory records that the user was looked up, dropping the oldest entries over the limit.
+func addUserHistory(entries []*userHistoryEntry, login string, now time.Time) []*userHistoryEntry {
+	if e := findUserHistory(entries, login); e != nil {
+		e.Login = login
+		e.LastUsed = now
+	} else {
+		entries = append(entries, &userHistoryEntry{Login: login, LastUsed: now})
+	}
+	sortUserHistory(entries)
+	ret := make([]*userHistoryEntry, 0, len(entries))
+	n := 0
+	for _, e := range entries {
+		if !e.Favorite {
+			if n >= userHistoryMaxEntries {
+				continue
+			}
+			n++
+		}
+		ret = append(ret, e)
+	}
+	return ret
+}
+
+// matchUserHistory returns the entries containing s in the login, the ones starting with s first.
+func matchUserHistory(entries []*userHistoryEntry, s string) []*userHistoryEntry {
+	s = strings.ToLower(strings.TrimSpace(s))
+	prefix, contains := make([]*userHistoryEntry, 0), make([]*userHistoryEntry, 0)
+	for _, e := range entries {
+		login := strings.ToLower(e.Login)
+		if strings.HasPrefix(login, s) {
+			prefix = append(prefix, e)
+		} else if strings.Contains(login, s) {
+			contains = append(contains, e)
+		}
+	}
+	return append(prefix, contains...)
+}
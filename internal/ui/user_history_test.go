@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAddUserHistory(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := func() []*userHistoryEntry {
+		return []*userHistoryEntry{
+			{Login: "alice", LastUsed: t0.Add(2 * time.Hour)},
+			{Login: "bob", LastUsed: t0.Add(1 * time.Hour), Favorite: true},
+			{Login: "carol", LastUsed: t0},
+		}
+	}
+	tests := []struct {
+		login string
+		want  []string
+	}{
+		{
+			login: "dave",
+			want:  []string{"bob", "dave", "alice", "carol"},
+		},
+		{
+			login: "carol",
+			want:  []string{"bob", "carol", "alice"},
+		},
+		{
+			// the login is matched case-insensitively, and takes the latest case
+			login: "Carol",
+			want:  []string{"bob", "Carol", "alice"},
+		},
+		{
+			login: "bob",
+			want:  []string{"bob", "alice", "carol"},
+		},
+	}
+	for _, test := range tests {
+		got := make([]string, 0)
+		for _, e := range addUserHistory(entries(), test.login, t0.Add(3*time.Hour)) {
+			got = append(got, e.Login)
+		}
+		if notEqual(got, test.want) {
+			t.Errorf("login: %s, got: %v, want: %v", test.login, got, test.want)
+		}
+	}
+}
+
+func TestAddUserHistoryLimit(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []*userHistoryEntry{{Login: "favorite", LastUsed: t0, Favorite: true}}
+	for i := 0; i < userHistoryMaxEntries; i++ {
+		entries = append(entries, &userHistoryEntry{Login: fmt.Sprintf("user%d", i), LastUsed: t0.Add(time.Duration(i) * time.Minute)})
+	}
+
+	got := addUserHistory(entries, "new", t0.Add(time.Hour))
+
+	if notEqual(len(got), userHistoryMaxEntries+1) {
+		t.Errorf("len got: %d, want: %d", len(got), userHistoryMaxEntries+1)
+	}
+	// the favorite is kept, and the oldest is dropped
+	if findUserHistory(got, "favorite") == nil {
+		t.Errorf("favorite is dropped")
+	}
+	if findUserHistory(got, "new") == nil {
+		t.Errorf("new is not added")
+	}
+	if findUserHistory(got, "user0") != nil {
+		t.Errorf("user0 is not dropped")
+	}
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "", b: "abc", want: 3},
+		{a: "abc", b: "", want: 3},
+		{a: "abc", b: "abc", want: 0},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "lusingander", b: "lusinganders", want: 1},
+		{a: "torvalds", b: "trovalds", want: 2},
+		{a: "日本語", b: "日本", want: 1},
+	}
+	for _, test := range tests {
+		if got := editDistance(test.a, test.b); notEqual(got, test.want) {
+			t.Errorf("a: %s, b: %s, got: %d, want: %d", test.a, test.b, got, test.want)
+		}
+		if got := editDistance(test.b, test.a); notEqual(got, test.want) {
+			t.Errorf("a: %s, b: %s, got: %d, want: %d", test.b, test.a, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lusingander/ghcv-cli/internal/clipboard"
+	"github.com/lusingander/ghcv-cli/internal/ghcv"
+	"github.com/muesli/reflow/truncate"
+)
+
+const (
+	// the notes are cut so that long urls do not widen the dialog
+	yankDialogNoteMaxWidth = 50
+
+	yankFormatUrl          = "URL"
+	yankFormatReference    = "Reference"
+	yankFormatMarkdownLink = "Markdown link"
+	yankFormatMarkdownList = "Visible as Markdown list"
+)
+
+// yankTarget is an item which can be copied, as its url, its reference or a Markdown link.
+type yankTarget struct {
+	url   string
+	ref   string // such as owner/repo#number
+	title string // written after the link in Markdown, if any
+}
+
+func userYankTarget(login string) *yankTarget {
+	return &yankTarget{url: ghcv.GitHubBaseUrl + login, ref: "@" + login}
+}
+
+func repositoryYankTarget(fullName, url string) *yankTarget {
+	return &yankTarget{url: url, ref: fullName}
+}
+
+func pullRequestYankTarget(fullName string, number int, title, url string) *yankTarget {
+	return &yankTarget{url: url, ref: fmt.Sprintf("%s#%d", fullName, number), title: title}
+}
+
+func (t *yankTarget) markdownLink() string {
+	link := fmt.Sprintf("[%s](%s)", t.ref, t.url)
+	if t.title != "" {
+		link += " " + t.title
+	}
+	return link
+}
+
+func markdownList(targets []*yankTarget) string {
+	lines := make([]string, len(targets))
+	for i, t := range targets {
+		lines[i] = "- " + t.markdownLink()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// yanker is implemented by the pages which have items to copy.
+type yanker interface {
+	// yankTargets returns the selected item, or nil, and the items shown by the page in order.
+	yankTargets() (*yankTarget, []*yankTarget)
+}
+
+// listYankTargets returns the targets of the selected item and of the items left by the filter.
+func listYankTargets[I list.Item](l list.Model, target func(I) *yankTarget) (*yankTarget, []*yankTarget) {
+	var selected *yankTarget
+	if item, ok := l.SelectedItem().(I); ok {
+		selected = target(item)
+	}
+	visible := make([]*yankTarget, 0)
+	for _, item := range l.VisibleItems() {
+		if item, ok := item.(I); ok {
+			visible = append(visible, target(item))
+		}
+	}
+	return selected, visible
+}
+
+type yankedMsg struct {
+	what   string
+	text   string
+	method clipboard.Method
+	err    error
+}
+
+var _ tea.Msg = (*yankedMsg)(nil)
+
+func yank(what, text string) tea.Cmd {
+	return func() tea.Msg {
+		// OSC 52 is sent by the application, which owns the output
+		return yankedMsg{what: what, text: text, method: clipboard.Copy(text)}
+	}
+}
+
+func (msg yankedMsg) flash() string {
+	if msg.err != nil {
+		return flashErrorStyle.Render("Failed to copy: " + msg.err.Error())
+	}
+	s := "Copied " + msg.what
+	if msg.method == clipboard.OSC52 {
+		s += " (OSC 52)"
+	}
+	return flashStyle.Render(s)
+}
+
+// yankDialog lets the user choose the format to copy in.
+type yankDialog struct {
+	*selectDialog
+	target  *yankTarget
+	visible []*yankTarget
+}
+
+func newYankDialog(closeKeys []string) *yankDialog {
+	return &yankDialog{
+		selectDialog: newSelectDialog("Copy", closeKeys, false),
+	}
+}
+
+func (d *yankDialog) open(target *yankTarget, visible []*yankTarget) {
+	d.target = target
+	d.visible = visible
+	items := make([]selectDialogItem, 0)
+	if target != nil {
+		items = append(items,
+			selectDialogItem{name: yankFormatUrl, note: yankDialogNote(target.url)},
+			selectDialogItem{name: yankFormatReference, note: yankDialogNote(target.ref)},
+			selectDialogItem{name: yankFormatMarkdownLink},
+		)
+	}
+	if len(visible) > 0 {
+		items = append(items, selectDialogItem{name: yankFormatMarkdownList, note: fmt.Sprintf("(%d)", len(visible))})
+	}
+	d.setItems(items)
+	d.selectDialog.open(0)
+}
+
+func yankDialogNote(s string) string {
+	if lipgloss.Width(s) <= yankDialogNoteMaxWidth {
+		return s
+	}
+	return truncate.StringWithTail(s, yankDialogNoteMaxWidth, "…")
+}
+
+// update returns the command to copy when a format is chosen.
+func (d *yankDialog) update(msg tea.Msg) tea.Cmd {
+	if d.selectDialog.update(msg) != selectDialogEntered {
+		return nil
+	}
+	d.opened = false
+	switch d.items[d.selected()].name {
+	case yankFormatUrl:
+		return yank("the URL", d.target.url)
+	case yankFormatReference:
+		return yank(d.target.ref, d.target.ref)
+	case yankFormatMarkdownLink:
+		return yank("the Markdown link", d.target.markdownLink())
+	case yankFormatMarkdownList:
+		return yank(fmt.Sprintf("%d items as a Markdown list", len(d.visible)), markdownList(d.visible))
+	}
+	return nil
+}